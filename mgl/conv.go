@@ -0,0 +1,448 @@
+// Copyright 2014 The go-gl/mathgl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+// This file is generated by codegen.go; DO NOT EDIT
+// Edit conv.tmpl and run "go generate" to make changes.
+
+package mgl
+
+import (
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Vec2From32 converts an mgl32.Vec2 to a Vec2 with elements of type T.
+func Vec2From32[T Float](v mgl32.Vec2) Vec2[T] {
+	var r Vec2[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Vec2From64 converts an mgl64.Vec2 to a Vec2 with elements of type T.
+func Vec2From64[T Float](v mgl64.Vec2) Vec2[T] {
+	var r Vec2[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Vec2 to an mgl32.Vec2.
+func (v Vec2[T]) Mgl32() mgl32.Vec2 {
+	var r mgl32.Vec2
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Vec2 to an mgl64.Vec2.
+func (v Vec2[T]) Mgl64() mgl64.Vec2 {
+	var r mgl64.Vec2
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Mat2From32 converts an mgl32.Mat2 to a Mat2 with elements of type T.
+func Mat2From32[T Float](v mgl32.Mat2) Mat2[T] {
+	var r Mat2[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mat2From64 converts an mgl64.Mat2 to a Mat2 with elements of type T.
+func Mat2From64[T Float](v mgl64.Mat2) Mat2[T] {
+	var r Mat2[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Mat2 to an mgl32.Mat2.
+func (v Mat2[T]) Mgl32() mgl32.Mat2 {
+	var r mgl32.Mat2
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Mat2 to an mgl64.Mat2.
+func (v Mat2[T]) Mgl64() mgl64.Mat2 {
+	var r mgl64.Mat2
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Mat2x3From32 converts an mgl32.Mat2x3 to a Mat2x3 with elements of type T.
+func Mat2x3From32[T Float](v mgl32.Mat2x3) Mat2x3[T] {
+	var r Mat2x3[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mat2x3From64 converts an mgl64.Mat2x3 to a Mat2x3 with elements of type T.
+func Mat2x3From64[T Float](v mgl64.Mat2x3) Mat2x3[T] {
+	var r Mat2x3[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Mat2x3 to an mgl32.Mat2x3.
+func (v Mat2x3[T]) Mgl32() mgl32.Mat2x3 {
+	var r mgl32.Mat2x3
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Mat2x3 to an mgl64.Mat2x3.
+func (v Mat2x3[T]) Mgl64() mgl64.Mat2x3 {
+	var r mgl64.Mat2x3
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Mat2x4From32 converts an mgl32.Mat2x4 to a Mat2x4 with elements of type T.
+func Mat2x4From32[T Float](v mgl32.Mat2x4) Mat2x4[T] {
+	var r Mat2x4[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mat2x4From64 converts an mgl64.Mat2x4 to a Mat2x4 with elements of type T.
+func Mat2x4From64[T Float](v mgl64.Mat2x4) Mat2x4[T] {
+	var r Mat2x4[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Mat2x4 to an mgl32.Mat2x4.
+func (v Mat2x4[T]) Mgl32() mgl32.Mat2x4 {
+	var r mgl32.Mat2x4
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Mat2x4 to an mgl64.Mat2x4.
+func (v Mat2x4[T]) Mgl64() mgl64.Mat2x4 {
+	var r mgl64.Mat2x4
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Vec3From32 converts an mgl32.Vec3 to a Vec3 with elements of type T.
+func Vec3From32[T Float](v mgl32.Vec3) Vec3[T] {
+	var r Vec3[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Vec3From64 converts an mgl64.Vec3 to a Vec3 with elements of type T.
+func Vec3From64[T Float](v mgl64.Vec3) Vec3[T] {
+	var r Vec3[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Vec3 to an mgl32.Vec3.
+func (v Vec3[T]) Mgl32() mgl32.Vec3 {
+	var r mgl32.Vec3
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Vec3 to an mgl64.Vec3.
+func (v Vec3[T]) Mgl64() mgl64.Vec3 {
+	var r mgl64.Vec3
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Mat3x2From32 converts an mgl32.Mat3x2 to a Mat3x2 with elements of type T.
+func Mat3x2From32[T Float](v mgl32.Mat3x2) Mat3x2[T] {
+	var r Mat3x2[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mat3x2From64 converts an mgl64.Mat3x2 to a Mat3x2 with elements of type T.
+func Mat3x2From64[T Float](v mgl64.Mat3x2) Mat3x2[T] {
+	var r Mat3x2[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Mat3x2 to an mgl32.Mat3x2.
+func (v Mat3x2[T]) Mgl32() mgl32.Mat3x2 {
+	var r mgl32.Mat3x2
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Mat3x2 to an mgl64.Mat3x2.
+func (v Mat3x2[T]) Mgl64() mgl64.Mat3x2 {
+	var r mgl64.Mat3x2
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Mat3From32 converts an mgl32.Mat3 to a Mat3 with elements of type T.
+func Mat3From32[T Float](v mgl32.Mat3) Mat3[T] {
+	var r Mat3[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mat3From64 converts an mgl64.Mat3 to a Mat3 with elements of type T.
+func Mat3From64[T Float](v mgl64.Mat3) Mat3[T] {
+	var r Mat3[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Mat3 to an mgl32.Mat3.
+func (v Mat3[T]) Mgl32() mgl32.Mat3 {
+	var r mgl32.Mat3
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Mat3 to an mgl64.Mat3.
+func (v Mat3[T]) Mgl64() mgl64.Mat3 {
+	var r mgl64.Mat3
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Mat3x4From32 converts an mgl32.Mat3x4 to a Mat3x4 with elements of type T.
+func Mat3x4From32[T Float](v mgl32.Mat3x4) Mat3x4[T] {
+	var r Mat3x4[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mat3x4From64 converts an mgl64.Mat3x4 to a Mat3x4 with elements of type T.
+func Mat3x4From64[T Float](v mgl64.Mat3x4) Mat3x4[T] {
+	var r Mat3x4[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Mat3x4 to an mgl32.Mat3x4.
+func (v Mat3x4[T]) Mgl32() mgl32.Mat3x4 {
+	var r mgl32.Mat3x4
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Mat3x4 to an mgl64.Mat3x4.
+func (v Mat3x4[T]) Mgl64() mgl64.Mat3x4 {
+	var r mgl64.Mat3x4
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Vec4From32 converts an mgl32.Vec4 to a Vec4 with elements of type T.
+func Vec4From32[T Float](v mgl32.Vec4) Vec4[T] {
+	var r Vec4[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Vec4From64 converts an mgl64.Vec4 to a Vec4 with elements of type T.
+func Vec4From64[T Float](v mgl64.Vec4) Vec4[T] {
+	var r Vec4[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Vec4 to an mgl32.Vec4.
+func (v Vec4[T]) Mgl32() mgl32.Vec4 {
+	var r mgl32.Vec4
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Vec4 to an mgl64.Vec4.
+func (v Vec4[T]) Mgl64() mgl64.Vec4 {
+	var r mgl64.Vec4
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Mat4x2From32 converts an mgl32.Mat4x2 to a Mat4x2 with elements of type T.
+func Mat4x2From32[T Float](v mgl32.Mat4x2) Mat4x2[T] {
+	var r Mat4x2[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mat4x2From64 converts an mgl64.Mat4x2 to a Mat4x2 with elements of type T.
+func Mat4x2From64[T Float](v mgl64.Mat4x2) Mat4x2[T] {
+	var r Mat4x2[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Mat4x2 to an mgl32.Mat4x2.
+func (v Mat4x2[T]) Mgl32() mgl32.Mat4x2 {
+	var r mgl32.Mat4x2
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Mat4x2 to an mgl64.Mat4x2.
+func (v Mat4x2[T]) Mgl64() mgl64.Mat4x2 {
+	var r mgl64.Mat4x2
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Mat4x3From32 converts an mgl32.Mat4x3 to a Mat4x3 with elements of type T.
+func Mat4x3From32[T Float](v mgl32.Mat4x3) Mat4x3[T] {
+	var r Mat4x3[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mat4x3From64 converts an mgl64.Mat4x3 to a Mat4x3 with elements of type T.
+func Mat4x3From64[T Float](v mgl64.Mat4x3) Mat4x3[T] {
+	var r Mat4x3[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Mat4x3 to an mgl32.Mat4x3.
+func (v Mat4x3[T]) Mgl32() mgl32.Mat4x3 {
+	var r mgl32.Mat4x3
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Mat4x3 to an mgl64.Mat4x3.
+func (v Mat4x3[T]) Mgl64() mgl64.Mat4x3 {
+	var r mgl64.Mat4x3
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
+
+// Mat4From32 converts an mgl32.Mat4 to a Mat4 with elements of type T.
+func Mat4From32[T Float](v mgl32.Mat4) Mat4[T] {
+	var r Mat4[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mat4From64 converts an mgl64.Mat4 to a Mat4 with elements of type T.
+func Mat4From64[T Float](v mgl64.Mat4) Mat4[T] {
+	var r Mat4[T]
+	for i := range v {
+		r[i] = T(v[i])
+	}
+	return r
+}
+
+// Mgl32 converts the Mat4 to an mgl32.Mat4.
+func (v Mat4[T]) Mgl32() mgl32.Mat4 {
+	var r mgl32.Mat4
+	for i := range v {
+		r[i] = float32(v[i])
+	}
+	return r
+}
+
+// Mgl64 converts the Mat4 to an mgl64.Mat4.
+func (v Mat4[T]) Mgl64() mgl64.Mat4 {
+	var r mgl64.Mat4
+	for i := range v {
+		r[i] = float64(v[i])
+	}
+	return r
+}
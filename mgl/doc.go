@@ -0,0 +1,32 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+//go:generate go run ../mgl32/codegen.go -template conv.tmpl -output conv.go
+
+/*
+Package mgl provides the vector and matrix types of mgl32 and mgl64 with the
+element type as a type parameter, so code can be written once for both
+precisions. For instance, Vec3[float32] has the same methods as mgl32.Vec3,
+and Mat4[float64] the same methods as mgl64.Mat4.
+
+The types are generated from mgl32 (see vector.go and matrix.go there), so they
+stay in sync with it method-for-method. The only exceptions are Vec4.Quat,
+since there is no generic quaternion type, and the integer vectors (Vec2i and
+so on) along with the conversions to them, which don't depend on the element
+type. Mat3.SymEigen isn't provided either, since the eigenvalue solver isn't
+part of the generated code, and neither are the text, JSON and binary encodings
+and the Parse functions of mgl32 and mgl64; convert to and from the concrete
+types to use them.
+
+Conversion functions to and from the concrete types, such as Vec3From32 and
+Mat4[T].Mgl64, allow code to be migrated one piece at a time. Where the
+precision is the same, a plain type conversion like mgl32.Vec3(v) for a
+Vec3[float32] works as well.
+
+This package requires Go 1.18 or later.
+*/
+package mgl
@@ -0,0 +1,170 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package mgl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+func TestVecMatchesMgl32(t *testing.T) {
+	t.Parallel()
+
+	a, b := mgl32.Vec3{1, -2, 3.5}, mgl32.Vec3{0.5, 4, -1}
+	ga, gb := Vec3From32[float32](a), Vec3From32[float32](b)
+
+	if r := ga.Cross(gb).Mgl32(); r != a.Cross(b) {
+		t.Errorf("Cross = %v, expected %v", r, a.Cross(b))
+	}
+	if r := ga.Add(gb).Mul(2).Mgl32(); r != a.Add(b).Mul(2) {
+		t.Errorf("Add and Mul = %v, expected %v", r, a.Add(b).Mul(2))
+	}
+	if r := ga.Normalize().Mgl32(); r != a.Normalize() {
+		t.Errorf("Normalize = %v, expected %v", r, a.Normalize())
+	}
+	if r := ga.Dot(gb); r != a.Dot(b) {
+		t.Errorf("Dot = %v, expected %v", r, a.Dot(b))
+	}
+	if r := ga.OuterProd4(Vec4[float32]{1, 2, 3, 4}).Mgl32(); r != a.OuterProd4(mgl32.Vec4{1, 2, 3, 4}) {
+		t.Errorf("OuterProd4 = %v, expected %v", r, a.OuterProd4(mgl32.Vec4{1, 2, 3, 4}))
+	}
+}
+
+func TestMatMatchesMgl64(t *testing.T) {
+	t.Parallel()
+
+	m := mgl64.Translate3D(1, 2, 3).Mul4(mgl64.HomogRotate3DY(0.7)).Mul4(mgl64.Scale3D(2, 3, 4))
+	gm := Mat4From64[float64](m)
+
+	if r := gm.Inv().Mgl64(); r != m.Inv() {
+		t.Errorf("Inv = %v, expected %v", r, m.Inv())
+	}
+	if r := gm.Det(); r != m.Det() {
+		t.Errorf("Det = %v, expected %v", r, m.Det())
+	}
+	if r := gm.Mul4(gm.Transpose()).Mgl64(); r != m.Mul4(m.Transpose()) {
+		t.Errorf("Mul4 = %v, expected %v", r, m.Mul4(m.Transpose()))
+	}
+
+	v := mgl64.Vec4{1, 2, 3, 1}
+	if r := gm.Mul4x1(Vec4From64[float64](v)).Mgl64(); r != m.Mul4x1(v) {
+		t.Errorf("Mul4x1 = %v, expected %v", r, m.Mul4x1(v))
+	}
+	if r := gm.String(); r != m.String() {
+		t.Errorf("String = %q, expected %q", r, m.String())
+	}
+	near := func(a, b float64) bool { return Abs(a-b) < 1e-12 }
+	if !gm.Mul4(gm.Inv()).ApproxFuncEqual(Ident4[float64](), near) {
+		t.Errorf("Product of matrix and its inverse is %v, expected identity", gm.Mul4(gm.Inv()))
+	}
+}
+
+func TestConversions(t *testing.T) {
+	t.Parallel()
+
+	m := mgl32.Mat3x2{1, 2, 3, 4, 5, 6}
+	if r := Mat3x2From32[float64](m).Mgl32(); r != m {
+		t.Errorf("Round trip of %v through float64 gives %v", m, r)
+	}
+	if r := Mat3x2From32[float64](m).Mgl64(); r != (mgl64.Mat3x2{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("Conversion of %v to mgl64 gives %v", m, r)
+	}
+
+	v := Vec2From64[float32](mgl64.Vec2{0.1, 1e300})
+	if v[0] != 0.1 || v[1] != mgl32.InfPos {
+		t.Errorf("Conversion to float32 gives %v, expected %v", v, Vec2[float32]{0.1, mgl32.InfPos})
+	}
+
+	// Identical memory layout
+	if r := mgl32.Vec3(Vec3[float32]{1, 2, 3}); r != (mgl32.Vec3{1, 2, 3}) {
+		t.Errorf("Type conversion gives %v", r)
+	}
+}
+
+// The methods of mgl32 that are documented as missing from the generic types,
+// and the ones only the generic types have.
+var (
+	onlyInMgl32 = map[string]bool{
+		"Quat":     true,
+		"SymEigen": true,
+		"Vec2i":    true,
+		"Vec3i":    true,
+		"Vec4i":    true,
+	}
+	onlyInGeneric = map[string]bool{
+		"Mgl32": true,
+		"Mgl64": true,
+	}
+)
+
+// methodSet returns the names of the methods of both t and *t.
+func methodSet(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	for _, t := range []reflect.Type{t, reflect.PtrTo(t)} {
+		for i := 0; i < t.NumMethod(); i++ {
+			names[t.Method(i).Name] = true
+		}
+	}
+	return names
+}
+
+func TestMethodSetsMatchMgl32(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		concrete, generic interface{}
+	}{
+		{mgl32.Vec2{}, Vec2[float32]{}},
+		{mgl32.Vec3{}, Vec3[float32]{}},
+		{mgl32.Vec4{}, Vec4[float32]{}},
+		{mgl32.Mat2{}, Mat2[float32]{}},
+		{mgl32.Mat2x3{}, Mat2x3[float32]{}},
+		{mgl32.Mat2x4{}, Mat2x4[float32]{}},
+		{mgl32.Mat3x2{}, Mat3x2[float32]{}},
+		{mgl32.Mat3{}, Mat3[float32]{}},
+		{mgl32.Mat3x4{}, Mat3x4[float32]{}},
+		{mgl32.Mat4x2{}, Mat4x2[float32]{}},
+		{mgl32.Mat4x3{}, Mat4x3[float32]{}},
+		{mgl32.Mat4{}, Mat4[float32]{}},
+	}
+
+	for _, test := range tests {
+		concrete := methodSet(reflect.TypeOf(test.concrete))
+		generic := methodSet(reflect.TypeOf(test.generic))
+		for name := range concrete {
+			// The encodings aren't provided by the generic types
+			encoding := strings.HasPrefix(name, "Marshal") || strings.HasPrefix(name, "Unmarshal")
+			if !generic[name] && !encoding && !onlyInMgl32[name] {
+				t.Errorf("%T.%s has no generic counterpart", test.concrete, name)
+			}
+		}
+		for name := range generic {
+			if !concrete[name] && !onlyInGeneric[name] {
+				t.Errorf("%T.%s has no counterpart in mgl32", test.generic, name)
+			}
+		}
+	}
+}
+
+func TestFloatEqual(t *testing.T) {
+	t.Parallel()
+
+	if !FloatEqual[float32](1e-39, 0) || !FloatEqual(1e-300, 0.0) {
+		t.Errorf("FloatEqual does not treat tiny numbers as zero")
+	}
+	if FloatEqual[float32](1, 1.001) || !FloatEqualThreshold[float32](1, 1.001, 1e-2) {
+		t.Errorf("FloatEqualThreshold does not respect the threshold")
+	}
+	if r := Abs(-2.5); r != 2.5 {
+		t.Errorf("Abs(-2.5) = %v", r)
+	}
+}
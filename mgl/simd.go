@@ -0,0 +1,23 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package mgl
+
+// The kernels called by the generated code, which mgl32 implements in assembly
+// on amd64. This package always uses the pure Go versions.
+
+func mat4Mul4[T Float](dst, m1, m2 *Mat4[T]) {
+	mat4Mul4Generic(dst, m1, m2)
+}
+
+func mat4Mul4x1[T Float](dst *Vec4[T], m1 *Mat4[T], m2 *Vec4[T]) {
+	mat4Mul4x1Generic(dst, m1, m2)
+}
+
+func mat4Inv[T Float](dst, m *Mat4[T]) T {
+	return mat4InvGeneric(dst, m)
+}
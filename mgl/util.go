@@ -0,0 +1,95 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package mgl
+
+import (
+	"math"
+)
+
+// Float is the constraint satisfied by the element types of the vectors and
+// matrices in this package.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Epsilon is the default threshold used by FloatEqual, and thus all of the
+// ApproxEqual methods, the same as mgl32.Epsilon and mgl64.Epsilon.
+var Epsilon = 1e-10
+
+// Abs is a generic version of mgl32.Abs.
+func Abs[T Float](a T) T {
+	if a < 0 {
+		return -a
+	} else if a == 0 {
+		return 0
+	}
+
+	return a
+}
+
+// FloatEqual is a generic version of mgl32.FloatEqual.
+func FloatEqual[T Float](a, b T) bool {
+	return FloatEqualThreshold(a, b, T(Epsilon))
+}
+
+// FloatEqualFunc is a generic version of mgl32.FloatEqualFunc.
+func FloatEqualFunc[T Float](epsilon T) func(T, T) bool {
+	return func(a, b T) bool {
+		return FloatEqualThreshold(a, b, epsilon)
+	}
+}
+
+// FloatEqualThreshold is a generic version of mgl32.FloatEqualThreshold. The
+// smallest normal number it compares against depends on the precision of T.
+func FloatEqualThreshold[T Float](a, b, epsilon T) bool {
+	if a == b { // Handles the case of inf or shortcuts the loop when no significant error has accumulated
+		return true
+	}
+
+	diff := Abs(a - b)
+	if a*b == 0 || diff < minNormal[T]() { // If a or b are 0 or both are extremely close to it
+		return diff < epsilon*epsilon
+	}
+
+	// Else compare difference
+	return diff/(Abs(a)+Abs(b)) < epsilon
+}
+
+// Clamp is a generic version of mgl32.Clamp.
+func Clamp[T Float](a, low, high T) T {
+	if a < low {
+		return low
+	} else if a > high {
+		return high
+	}
+
+	return a
+}
+
+// SetMin is a generic version of mgl32.SetMin.
+func SetMin[T Float](a, b *T) {
+	if *b < *a {
+		*a = *b
+	}
+}
+
+// SetMax is a generic version of mgl32.SetMax.
+func SetMax[T Float](a, b *T) {
+	if *a < *b {
+		*a = *b
+	}
+}
+
+// minNormal returns the smallest positive normal number of type T.
+func minNormal[T Float]() T {
+	// Half of the smallest float32 only rounds to zero in single precision
+	if T(math.SmallestNonzeroFloat32/2) == 0 {
+		return T(1.1754943508222875e-38) // 1 / 2**(127 - 1)
+	}
+	return T(2.2250738585072014e-308) // 1 / 2**(1023 - 1)
+}
@@ -0,0 +1,422 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// AABB2 is an axis-aligned bounding box in 2D space, described by its minimum
+// and maximum corners.
+//
+// A box where any element of Min is greater than the corresponding element of
+// Max is considered empty. EmptyAABB2 returns the canonical empty box, which
+// can be grown with ExtendPoint and Union.
+type AABB2 struct {
+	Min, Max Vec2
+}
+
+// AABB3 is an axis-aligned bounding box in 3D space, described by its minimum
+// and maximum corners.
+//
+// A box where any element of Min is greater than the corresponding element of
+// Max is considered empty. EmptyAABB3 returns the canonical empty box, which
+// can be grown with ExtendPoint and Union.
+type AABB3 struct {
+	Min, Max Vec3
+}
+
+// EmptyAABB2 returns an empty box, with Min at positive infinity and Max at
+// negative infinity. Extending it by any point yields a degenerate box
+// containing just that point.
+func EmptyAABB2() AABB2 {
+	return AABB2{Vec2{InfPos, InfPos}, Vec2{InfNeg, InfNeg}}
+}
+
+// EmptyAABB3 returns an empty box, with Min at positive infinity and Max at
+// negative infinity. Extending it by any point yields a degenerate box
+// containing just that point.
+func EmptyAABB3() AABB3 {
+	return AABB3{Vec3{InfPos, InfPos, InfPos}, Vec3{InfNeg, InfNeg, InfNeg}}
+}
+
+// AABB2FromPoints returns the smallest box containing every point in points.
+// If points is empty, the result is EmptyAABB2().
+func AABB2FromPoints(points []Vec2) AABB2 {
+	b := EmptyAABB2()
+	for _, p := range points {
+		b = b.ExtendPoint(p)
+	}
+
+	return b
+}
+
+// AABB3FromPoints returns the smallest box containing every point in points.
+// If points is empty, the result is EmptyAABB3().
+func AABB3FromPoints(points []Vec3) AABB3 {
+	b := EmptyAABB3()
+	for _, p := range points {
+		b = b.ExtendPoint(p)
+	}
+
+	return b
+}
+
+// AABB2FromCenter builds a box from its center and half-extents.
+func AABB2FromCenter(center, halfExtents Vec2) AABB2 {
+	return AABB2{center.Sub(halfExtents), center.Add(halfExtents)}
+}
+
+// AABB3FromCenter builds a box from its center and half-extents.
+func AABB3FromCenter(center, halfExtents Vec3) AABB3 {
+	return AABB3{center.Sub(halfExtents), center.Add(halfExtents)}
+}
+
+// IsEmpty returns whether the box contains no points at all, that is, if Min
+// is greater than Max along any axis.
+func (b AABB2) IsEmpty() bool {
+	return b.Min[0] > b.Max[0] || b.Min[1] > b.Max[1]
+}
+
+// IsEmpty returns whether the box contains no points at all, that is, if Min
+// is greater than Max along any axis.
+func (b AABB3) IsEmpty() bool {
+	return b.Min[0] > b.Max[0] || b.Min[1] > b.Max[1] || b.Min[2] > b.Max[2]
+}
+
+// Center returns the point halfway between Min and Max.
+func (b AABB2) Center() Vec2 {
+	return b.Min.Add(b.Max).Mul(0.5)
+}
+
+// Center returns the point halfway between Min and Max.
+func (b AABB3) Center() Vec3 {
+	return b.Min.Add(b.Max).Mul(0.5)
+}
+
+// Size returns the length of the box along each axis (Max-Min).
+func (b AABB2) Size() Vec2 {
+	return b.Max.Sub(b.Min)
+}
+
+// Size returns the length of the box along each axis (Max-Min).
+func (b AABB3) Size() Vec3 {
+	return b.Max.Sub(b.Min)
+}
+
+// HalfExtents returns half of the box's Size, that is, the distance from the
+// center to the faces along each axis.
+func (b AABB2) HalfExtents() Vec2 {
+	return b.Max.Sub(b.Min).Mul(0.5)
+}
+
+// HalfExtents returns half of the box's Size, that is, the distance from the
+// center to the faces along each axis.
+func (b AABB3) HalfExtents() Vec3 {
+	return b.Max.Sub(b.Min).Mul(0.5)
+}
+
+// Area returns the area enclosed by the box. Empty boxes have an area of 0.
+func (b AABB2) Area() float32 {
+	if b.IsEmpty() {
+		return 0
+	}
+	s := b.Size()
+
+	return s[0] * s[1]
+}
+
+// Volume returns the volume enclosed by the box. Empty boxes have a volume of
+// 0.
+func (b AABB3) Volume() float32 {
+	if b.IsEmpty() {
+		return 0
+	}
+	s := b.Size()
+
+	return s[0] * s[1] * s[2]
+}
+
+// SurfaceArea returns the total area of the six faces of the box. Empty boxes
+// have a surface area of 0.
+func (b AABB3) SurfaceArea() float32 {
+	if b.IsEmpty() {
+		return 0
+	}
+	s := b.Size()
+
+	return 2 * (s[0]*s[1] + s[1]*s[2] + s[2]*s[0])
+}
+
+// Corners returns the four corners of the box, ordered such that bit i of the
+// index selects Max (if set) or Min (if unset) along axis i.
+func (b AABB2) Corners() [4]Vec2 {
+	var c [4]Vec2
+	for i := range c {
+		for j := 0; j < 2; j++ {
+			if i&(1<<uint(j)) != 0 {
+				c[i][j] = b.Max[j]
+			} else {
+				c[i][j] = b.Min[j]
+			}
+		}
+	}
+
+	return c
+}
+
+// Corners returns the eight corners of the box, ordered such that bit i of the
+// index selects Max (if set) or Min (if unset) along axis i.
+func (b AABB3) Corners() [8]Vec3 {
+	var c [8]Vec3
+	for i := range c {
+		for j := 0; j < 3; j++ {
+			if i&(1<<uint(j)) != 0 {
+				c[i][j] = b.Max[j]
+			} else {
+				c[i][j] = b.Min[j]
+			}
+		}
+	}
+
+	return c
+}
+
+// ExtendPoint returns the smallest box containing both b and p.
+func (b AABB2) ExtendPoint(p Vec2) AABB2 {
+	for i := range p {
+		SetMin(&b.Min[i], &p[i])
+		SetMax(&b.Max[i], &p[i])
+	}
+
+	return b
+}
+
+// ExtendPoint returns the smallest box containing both b and p.
+func (b AABB3) ExtendPoint(p Vec3) AABB3 {
+	for i := range p {
+		SetMin(&b.Min[i], &p[i])
+		SetMax(&b.Max[i], &p[i])
+	}
+
+	return b
+}
+
+// Union returns the smallest box containing both b1 and b2. The union with an
+// empty box is the other box.
+func (b1 AABB2) Union(b2 AABB2) AABB2 {
+	for i := range b1.Min {
+		SetMin(&b1.Min[i], &b2.Min[i])
+		SetMax(&b1.Max[i], &b2.Max[i])
+	}
+
+	return b1
+}
+
+// Union returns the smallest box containing both b1 and b2. The union with an
+// empty box is the other box.
+func (b1 AABB3) Union(b2 AABB3) AABB3 {
+	for i := range b1.Min {
+		SetMin(&b1.Min[i], &b2.Min[i])
+		SetMax(&b1.Max[i], &b2.Max[i])
+	}
+
+	return b1
+}
+
+// Intersection returns the box of points that are in both b1 and b2. If the
+// boxes do not overlap the result will be empty (see IsEmpty).
+func (b1 AABB2) Intersection(b2 AABB2) AABB2 {
+	for i := range b1.Min {
+		SetMax(&b1.Min[i], &b2.Min[i])
+		SetMin(&b1.Max[i], &b2.Max[i])
+	}
+
+	return b1
+}
+
+// Intersection returns the box of points that are in both b1 and b2. If the
+// boxes do not overlap the result will be empty (see IsEmpty).
+func (b1 AABB3) Intersection(b2 AABB3) AABB3 {
+	for i := range b1.Min {
+		SetMax(&b1.Min[i], &b2.Min[i])
+		SetMin(&b1.Max[i], &b2.Max[i])
+	}
+
+	return b1
+}
+
+// ContainsPoint returns whether p is inside the box or on its boundary.
+func (b AABB2) ContainsPoint(p Vec2) bool {
+	return p[0] >= b.Min[0] && p[0] <= b.Max[0] &&
+		p[1] >= b.Min[1] && p[1] <= b.Max[1]
+}
+
+// ContainsPoint returns whether p is inside the box or on its boundary.
+func (b AABB3) ContainsPoint(p Vec3) bool {
+	return p[0] >= b.Min[0] && p[0] <= b.Max[0] &&
+		p[1] >= b.Min[1] && p[1] <= b.Max[1] &&
+		p[2] >= b.Min[2] && p[2] <= b.Max[2]
+}
+
+// Support returns the corner of the box that is farthest in the direction
+// dir, which makes AABB3 a Shape.
+func (b AABB3) Support(dir Vec3) Vec3 {
+	p := b.Min
+	for i := range dir {
+		if dir[i] > 0 {
+			p[i] = b.Max[i]
+		}
+	}
+
+	return p
+}
+
+// Contains returns whether b2 lies entirely within b1. An empty b2 is
+// contained by any box.
+func (b1 AABB2) Contains(b2 AABB2) bool {
+	if b2.IsEmpty() {
+		return true
+	}
+
+	return b1.ContainsPoint(b2.Min) && b1.ContainsPoint(b2.Max)
+}
+
+// Contains returns whether b2 lies entirely within b1. An empty b2 is
+// contained by any box.
+func (b1 AABB3) Contains(b2 AABB3) bool {
+	if b2.IsEmpty() {
+		return true
+	}
+
+	return b1.ContainsPoint(b2.Min) && b1.ContainsPoint(b2.Max)
+}
+
+// Intersects returns whether the two boxes overlap. Boxes that merely touch
+// along a face, edge or corner are considered to overlap.
+func (b1 AABB2) Intersects(b2 AABB2) bool {
+	return b1.Min[0] <= b2.Max[0] && b1.Max[0] >= b2.Min[0] &&
+		b1.Min[1] <= b2.Max[1] && b1.Max[1] >= b2.Min[1]
+}
+
+// Intersects returns whether the two boxes overlap. Boxes that merely touch
+// along a face, edge or corner are considered to overlap.
+func (b1 AABB3) Intersects(b2 AABB3) bool {
+	return b1.Min[0] <= b2.Max[0] && b1.Max[0] >= b2.Min[0] &&
+		b1.Min[1] <= b2.Max[1] && b1.Max[1] >= b2.Min[1] &&
+		b1.Min[2] <= b2.Max[2] && b1.Max[2] >= b2.Min[2]
+}
+
+// ClosestPoint returns the point in (or on) the box that is closest to p. If
+// p is inside the box, p itself is returned.
+func (b AABB2) ClosestPoint(p Vec2) Vec2 {
+	for i := range p {
+		p[i] = Clamp(p[i], b.Min[i], b.Max[i])
+	}
+
+	return p
+}
+
+// ClosestPoint returns the point in (or on) the box that is closest to p. If
+// p is inside the box, p itself is returned.
+func (b AABB3) ClosestPoint(p Vec3) Vec3 {
+	for i := range p {
+		p[i] = Clamp(p[i], b.Min[i], b.Max[i])
+	}
+
+	return p
+}
+
+// DistSqr returns the square of the distance between p and the closest point
+// of the box. Points inside the box have a distance of 0.
+func (b AABB2) DistSqr(p Vec2) float32 {
+	return b.ClosestPoint(p).Sub(p).LenSqr()
+}
+
+// DistSqr returns the square of the distance between p and the closest point
+// of the box. Points inside the box have a distance of 0.
+func (b AABB3) DistSqr(p Vec3) float32 {
+	return b.ClosestPoint(p).Sub(p).LenSqr()
+}
+
+// Transform returns the tightest axis-aligned box containing b after it has
+// been transformed by the homogeneous 2D matrix m.
+//
+// This uses Arvo's method, so m is assumed to be affine (the bottom row is
+// [0 0 1]). An empty box stays empty.
+func (b AABB2) Transform(m Mat3) AABB2 {
+	if b.IsEmpty() {
+		return b
+	}
+
+	out := AABB2{Vec2{m[6], m[7]}, Vec2{m[6], m[7]}}
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			e, f := m[j*3+i]*b.Min[j], m[j*3+i]*b.Max[j]
+			if e < f {
+				out.Min[i] += e
+				out.Max[i] += f
+			} else {
+				out.Min[i] += f
+				out.Max[i] += e
+			}
+		}
+	}
+
+	return out
+}
+
+// Transform returns the tightest axis-aligned box containing b after it has
+// been transformed by the homogeneous matrix m.
+//
+// This uses Arvo's method ("Transforming Axis-Aligned Bounding Boxes",
+// Graphics Gems, 1990): instead of transforming all eight corners, each
+// element of the upper 3x3 part of m contributes its smaller product to Min
+// and its larger product to Max. The matrix is assumed to be affine (the bottom
+// row is [0 0 0 1]); for projective transforms, transform the Corners with
+// TransformCoordinate instead. An empty box stays empty.
+func (b AABB3) Transform(m Mat4) AABB3 {
+	if b.IsEmpty() {
+		return b
+	}
+
+	out := AABB3{Vec3{m[12], m[13], m[14]}, Vec3{m[12], m[13], m[14]}}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			e, f := m[j*4+i]*b.Min[j], m[j*4+i]*b.Max[j]
+			if e < f {
+				out.Min[i] += e
+				out.Max[i] += f
+			} else {
+				out.Min[i] += f
+				out.Max[i] += e
+			}
+		}
+	}
+
+	return out
+}
+
+// ApproxEqual returns whether the corners of the two boxes are approximately
+// equal, as if FloatEqual had been called on each element.
+func (b1 AABB2) ApproxEqual(b2 AABB2) bool {
+	return b1.Min.ApproxEqual(b2.Min) && b1.Max.ApproxEqual(b2.Max)
+}
+
+// ApproxEqual returns whether the corners of the two boxes are approximately
+// equal, as if FloatEqual had been called on each element.
+func (b1 AABB3) ApproxEqual(b2 AABB3) bool {
+	return b1.Min.ApproxEqual(b2.Min) && b1.Max.ApproxEqual(b2.Max)
+}
+
+// ApproxEqualThreshold returns whether the corners of the two boxes are
+// approximately equal with a given tolerance, as if FloatEqualThreshold had
+// been called on each element.
+func (b1 AABB2) ApproxEqualThreshold(b2 AABB2, epsilon float32) bool {
+	return b1.Min.ApproxEqualThreshold(b2.Min, epsilon) && b1.Max.ApproxEqualThreshold(b2.Max, epsilon)
+}
+
+// ApproxEqualThreshold returns whether the corners of the two boxes are
+// approximately equal with a given tolerance, as if FloatEqualThreshold had
+// been called on each element.
+func (b1 AABB3) ApproxEqualThreshold(b2 AABB3, epsilon float32) bool {
+	return b1.Min.ApproxEqualThreshold(b2.Min, epsilon) && b1.Max.ApproxEqualThreshold(b2.Max, epsilon)
+}
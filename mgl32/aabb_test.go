@@ -0,0 +1,203 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestAABB3FromPoints(t *testing.T) {
+	t.Parallel()
+
+	points := []Vec3{{1, -2, 3}, {-4, 5, 0}, {2, 2, -6}}
+	b := AABB3FromPoints(points)
+	expected := AABB3{Vec3{-4, -2, -6}, Vec3{2, 5, 3}}
+
+	if b != expected {
+		t.Errorf("AABB3FromPoints(%v) = %v, expected %v", points, b, expected)
+	}
+
+	if empty := AABB3FromPoints(nil); !empty.IsEmpty() {
+		t.Errorf("AABB3FromPoints(nil) is not empty: %v", empty)
+	}
+	if b.IsEmpty() {
+		t.Errorf("%v reported as empty", b)
+	}
+}
+
+func TestAABB2FromPoints(t *testing.T) {
+	t.Parallel()
+
+	points := []Vec2{{1, -2}, {-4, 5}, {2, 2}}
+	b := AABB2FromPoints(points)
+	expected := AABB2{Vec2{-4, -2}, Vec2{2, 5}}
+
+	if b != expected {
+		t.Errorf("AABB2FromPoints(%v) = %v, expected %v", points, b, expected)
+	}
+	if area := b.Area(); !FloatEqual(area, 42) {
+		t.Errorf("Area of %v is %v, expected 42", b, area)
+	}
+}
+
+func TestAABB3Measures(t *testing.T) {
+	t.Parallel()
+
+	b := AABB3FromCenter(Vec3{1, 2, 3}, Vec3{1, 2, 3})
+
+	if !b.Center().ApproxEqual(Vec3{1, 2, 3}) {
+		t.Errorf("Center of %v is %v", b, b.Center())
+	}
+	if !b.Size().ApproxEqual(Vec3{2, 4, 6}) {
+		t.Errorf("Size of %v is %v", b, b.Size())
+	}
+	if !b.HalfExtents().ApproxEqual(Vec3{1, 2, 3}) {
+		t.Errorf("HalfExtents of %v is %v", b, b.HalfExtents())
+	}
+	if !FloatEqual(b.Volume(), 48) {
+		t.Errorf("Volume of %v is %v, expected 48", b, b.Volume())
+	}
+	if !FloatEqual(b.SurfaceArea(), 88) {
+		t.Errorf("SurfaceArea of %v is %v, expected 88", b, b.SurfaceArea())
+	}
+	if e := EmptyAABB3(); e.Volume() != 0 || e.SurfaceArea() != 0 {
+		t.Errorf("Empty box has non-zero volume or surface area")
+	}
+}
+
+func TestAABB3UnionIntersection(t *testing.T) {
+	t.Parallel()
+
+	a := AABB3{Vec3{0, 0, 0}, Vec3{2, 2, 2}}
+	b := AABB3{Vec3{1, -1, 1}, Vec3{3, 1, 4}}
+
+	if u, expected := a.Union(b), (AABB3{Vec3{0, -1, 0}, Vec3{3, 2, 4}}); u != expected {
+		t.Errorf("Union of %v and %v is %v, expected %v", a, b, u, expected)
+	}
+	if u := a.Union(EmptyAABB3()); u != a {
+		t.Errorf("Union with empty box is %v, expected %v", u, a)
+	}
+
+	if i, expected := a.Intersection(b), (AABB3{Vec3{1, 0, 1}, Vec3{2, 1, 2}}); i != expected {
+		t.Errorf("Intersection of %v and %v is %v, expected %v", a, b, i, expected)
+	}
+
+	far := AABB3{Vec3{5, 5, 5}, Vec3{6, 6, 6}}
+	if i := a.Intersection(far); !i.IsEmpty() {
+		t.Errorf("Intersection of disjoint boxes %v and %v is not empty: %v", a, far, i)
+	}
+}
+
+func TestAABB3Overlap(t *testing.T) {
+	t.Parallel()
+
+	a := AABB3{Vec3{0, 0, 0}, Vec3{2, 2, 2}}
+
+	tests := []struct {
+		Box                  AABB3
+		Intersects, Contains bool
+	}{
+		{AABB3{Vec3{0.5, 0.5, 0.5}, Vec3{1, 1, 1}}, true, true},
+		{AABB3{Vec3{1, 1, 1}, Vec3{3, 3, 3}}, true, false},
+		{AABB3{Vec3{2, 0, 0}, Vec3{3, 1, 1}}, true, false},
+		{AABB3{Vec3{2.5, 0, 0}, Vec3{3, 1, 1}}, false, false},
+		{AABB3{Vec3{-1, -1, -1}, Vec3{3, 3, 3}}, true, false},
+		{EmptyAABB3(), false, true},
+	}
+
+	for _, c := range tests {
+		if r := a.Intersects(c.Box); r != c.Intersects {
+			t.Errorf("%v.Intersects(%v) = %v, expected %v", a, c.Box, r, c.Intersects)
+		}
+		if r := c.Box.Intersects(a); r != c.Intersects {
+			t.Errorf("%v.Intersects(%v) = %v, expected %v", c.Box, a, r, c.Intersects)
+		}
+		if r := a.Contains(c.Box); r != c.Contains {
+			t.Errorf("%v.Contains(%v) = %v, expected %v", a, c.Box, r, c.Contains)
+		}
+	}
+}
+
+func TestAABB3ClosestPoint(t *testing.T) {
+	t.Parallel()
+
+	b := AABB3{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}
+
+	tests := []struct {
+		Point, Closest Vec3
+		DistSqr        float32
+	}{
+		{Vec3{0, 0.5, 0}, Vec3{0, 0.5, 0}, 0},
+		{Vec3{3, 0, 0}, Vec3{1, 0, 0}, 4},
+		{Vec3{2, 2, 0}, Vec3{1, 1, 0}, 2},
+		{Vec3{-2, 3, -4}, Vec3{-1, 1, -1}, 14},
+	}
+
+	for _, c := range tests {
+		if r := b.ClosestPoint(c.Point); !r.ApproxEqual(c.Closest) {
+			t.Errorf("ClosestPoint(%v) = %v, expected %v", c.Point, r, c.Closest)
+		}
+		if r := b.DistSqr(c.Point); !FloatEqual(r, c.DistSqr) {
+			t.Errorf("DistSqr(%v) = %v, expected %v", c.Point, r, c.DistSqr)
+		}
+		if r := b.ContainsPoint(c.Point); r != (c.DistSqr == 0) {
+			t.Errorf("ContainsPoint(%v) = %v", c.Point, r)
+		}
+	}
+}
+
+func TestAABB3Transform(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	b := AABB3{Vec3{-1, 0, 2}, Vec3{3, 1, 5}}
+
+	for i := 0; i < 50; i++ {
+		axis := Vec3{r.Float32() - 0.5, r.Float32() - 0.5, r.Float32() - 0.5}.Normalize()
+		m := Translate3D(r.Float32()*10, r.Float32()*10, r.Float32()*10).
+			Mul4(HomogRotate3D(r.Float32()*2*math.Pi, axis)).
+			Mul4(Scale3D(r.Float32()*4-2, r.Float32()*4-2, r.Float32()*4-2))
+
+		corners := b.Corners()
+		transformed := make([]Vec3, len(corners))
+		for j, c := range corners {
+			transformed[j] = TransformCoordinate(c, m)
+		}
+
+		expected := AABB3FromPoints(transformed)
+		if got := b.Transform(m); !got.ApproxEqualThreshold(expected, 1e-4) {
+			t.Errorf("Transform of %v by %v is %v, expected %v", b, m, got, expected)
+		}
+	}
+
+	if e := EmptyAABB3().Transform(Translate3D(1, 2, 3)); !e.IsEmpty() {
+		t.Errorf("Transform of empty box is not empty: %v", e)
+	}
+}
+
+func TestAABB2Transform(t *testing.T) {
+	t.Parallel()
+
+	b := AABB2{Vec2{0, 0}, Vec2{2, 1}}
+	m := Translate2D(1, 1).Mul3(HomogRotate2D(math.Pi / 2))
+	expected := AABB2{Vec2{0, 1}, Vec2{1, 3}}
+
+	if got := b.Transform(m); got.Min.Sub(expected.Min).Len() > 1e-5 || got.Max.Sub(expected.Max).Len() > 1e-5 {
+		t.Errorf("Transform of %v is %v, expected %v", b, got, expected)
+	}
+}
+
+func TestAABB3Corners(t *testing.T) {
+	t.Parallel()
+
+	b := AABB3{Vec3{0, 1, 2}, Vec3{3, 4, 5}}
+	c := b.Corners()
+
+	if c[0] != b.Min || c[7] != b.Max || c[5] != (Vec3{3, 1, 5}) {
+		t.Errorf("Unexpected corners for %v: %v", b, c)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// This file contains functions operating on whole slices of vectors and
+// matrices, for bulk work like transforming meshes or skinning. They give the
+// same results as calling the single element function on every element, but
+// avoid copying the matrix for each call.
+//
+// The functions taking a dst slice store their results in it, reusing its
+// memory if it has the capacity to hold len(src) elements, and allocating a new
+// slice otherwise (so dst may be nil). The slice returned is dst, resliced to
+// len(src). The dst and src slices may be the same slice, which transforms the
+// elements in place, but must not overlap in any other way.
+
+// TransformCoordinates applies TransformCoordinate to every vector in src,
+// storing the results in dst.
+func TransformCoordinates(dst, src []Vec3, m Mat4) []Vec3 {
+	dst = resizeVec3s(dst, len(src))
+	transformCoordinates(dst, src, &m)
+
+	return dst
+}
+
+// TransformNormals applies TransformNormal to every vector in src, storing
+// the results in dst. Like TransformNormal, the results are not normalized,
+// and to transform normals correctly under non-uniform scaling m should be the
+// inverse transpose of the transformation applied to the points.
+func TransformNormals(dst, src []Vec3, m Mat4) []Vec3 {
+	dst = resizeVec3s(dst, len(src))
+	transformNormals(dst, src, &m)
+
+	return dst
+}
+
+// NormalizeAll normalizes every vector in vs in place. Like Normalize, a zero
+// vector results in NaNs or infinities.
+func NormalizeAll(vs []Vec3) {
+	normalizeAll(vs)
+}
+
+// MulMat4Batch multiplies the matrices of a and b pairwise, storing a[i]*b[i]
+// in dst[i], for instance to combine the world matrices of the bones of a
+// skeleton with their inverse bind matrices. If b is shorter than a, this
+// panics.
+func MulMat4Batch(dst, a, b []Mat4) []Mat4 {
+	b = b[:len(a)]
+	if cap(dst) < len(a) {
+		dst = make([]Mat4, len(a))
+	}
+	dst = dst[:len(a)]
+
+	mulMat4Batch(dst, a, b)
+
+	return dst
+}
+
+// resizeVec3s returns dst resliced to length n, or a new slice if its capacity
+// is too small.
+func resizeVec3s(dst []Vec3, n int) []Vec3 {
+	if cap(dst) < n {
+		return make([]Vec3, n)
+	}
+	return dst[:n]
+}
+
+// transformCoordinatesGeneric is the pure Go implementation of
+// TransformCoordinates, for len(dst) == len(src).
+func transformCoordinatesGeneric(dst, src []Vec3, m *Mat4) {
+	for i := range src {
+		x, y, z := src[i][0], src[i][1], src[i][2]
+		w := 1 / (m[3]*x + m[7]*y + m[11]*z + m[15])
+		dst[i] = Vec3{
+			(m[0]*x + m[4]*y + m[8]*z + m[12]) * w,
+			(m[1]*x + m[5]*y + m[9]*z + m[13]) * w,
+			(m[2]*x + m[6]*y + m[10]*z + m[14]) * w,
+		}
+	}
+}
+
+// transformNormalsGeneric is the pure Go implementation of TransformNormals,
+// for len(dst) == len(src).
+func transformNormalsGeneric(dst, src []Vec3, m *Mat4) {
+	for i := range src {
+		x, y, z := src[i][0], src[i][1], src[i][2]
+		dst[i] = Vec3{
+			m[0]*x + m[4]*y + m[8]*z,
+			m[1]*x + m[5]*y + m[9]*z,
+			m[2]*x + m[6]*y + m[10]*z,
+		}
+	}
+}
+
+// normalizeAllGeneric is the pure Go implementation of NormalizeAll.
+func normalizeAllGeneric(vs []Vec3) {
+	for i := range vs {
+		vs[i] = vs[i].Normalize()
+	}
+}
+
+// mulMat4BatchGeneric is the pure Go implementation of MulMat4Batch, for
+// len(dst) == len(a) == len(b).
+func mulMat4BatchGeneric(dst, a, b []Mat4) {
+	for i := range a {
+		mat4Mul4Generic(&dst[i], &a[i], &b[i])
+	}
+}
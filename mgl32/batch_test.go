@@ -0,0 +1,89 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func randVec3s(r *rand.Rand, n int) []Vec3 {
+	vs := make([]Vec3, n)
+	for i := range vs {
+		vs[i] = Vec3{r.Float32()*2 - 1, r.Float32()*2 - 1, r.Float32()*2 - 1}
+	}
+	return vs
+}
+
+func TestTransformCoordinates(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	src := randVec3s(r, 100)
+	tests := []Mat4{
+		Translate3D(1, 2, 3).Mul4(HomogRotate3DX(0.5)).Mul4(Scale3D(2, 1, 3)),
+		Perspective(DegToRad(60), 1.5, 0.1, 100).Mul4(Translate3D(0, 0, -5)),
+	}
+
+	for _, m := range tests {
+		dst := TransformCoordinates(nil, src, m)
+		normals := TransformNormals(make([]Vec3, 0, len(src)), src, m)
+		if len(dst) != len(src) || len(normals) != len(src) {
+			t.Fatalf("Got %d coordinates and %d normals, expected %d", len(dst), len(normals), len(src))
+		}
+
+		for i, v := range src {
+			if e := TransformCoordinate(v, m); !dst[i].ApproxEqualThreshold(e, 1e-5) {
+				t.Errorf("TransformCoordinates of %v = %v, expected %v", v, dst[i], e)
+			}
+			if e := TransformNormal(v, m); !normals[i].ApproxEqualThreshold(e, 1e-5) {
+				t.Errorf("TransformNormals of %v = %v, expected %v", v, normals[i], e)
+			}
+		}
+	}
+}
+
+func TestTransformCoordinatesInPlace(t *testing.T) {
+	t.Parallel()
+
+	vs := []Vec3{{1, 2, 3}, {-1, 0, 1}}
+	m := Translate3D(1, 1, 1)
+
+	res := TransformCoordinates(vs, vs, m)
+	if &res[0] != &vs[0] {
+		t.Errorf("TransformCoordinates did not reuse dst")
+	}
+	if vs[0] != (Vec3{2, 3, 4}) || vs[1] != (Vec3{0, 1, 2}) {
+		t.Errorf("In place translation gives %v", vs)
+	}
+}
+
+func TestNormalizeAll(t *testing.T) {
+	t.Parallel()
+
+	vs := []Vec3{{3, 0, 4}, {0, -2, 0}}
+	NormalizeAll(vs)
+
+	if !vs[0].ApproxEqual(Vec3{0.6, 0, 0.8}) || !vs[1].ApproxEqual(Vec3{0, -1, 0}) {
+		t.Errorf("NormalizeAll gives %v", vs)
+	}
+}
+
+func TestMulMat4Batch(t *testing.T) {
+	t.Parallel()
+
+	a := []Mat4{Translate3D(1, 2, 3), HomogRotate3DY(1)}
+	b := []Mat4{Scale3D(2, 2, 2), Translate3D(-1, 0, 0), Ident4()}
+
+	dst := MulMat4Batch(nil, a, b)
+	if len(dst) != len(a) {
+		t.Fatalf("MulMat4Batch returned %d matrices, expected %d", len(dst), len(a))
+	}
+	for i := range dst {
+		if e := a[i].Mul4(b[i]); dst[i] != e {
+			t.Errorf("MulMat4Batch element %d = %v, expected %v", i, dst[i], e)
+		}
+	}
+}
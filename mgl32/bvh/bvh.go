@@ -0,0 +1,317 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bvh implements a bounding volume hierarchy of axis-aligned boxes
+// over a set of triangles or boxes, for ray casts, overlap queries and
+// nearest point queries that only look at the primitives near the query.
+package bvh
+
+import (
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+const (
+	// Nodes with at most minLeafSize primitives are always leaves, and nodes
+	// with more than maxLeafSize are always split. In between, the surface
+	// area heuristic decides.
+	minLeafSize = 2
+	maxLeafSize = 8
+
+	// The number of bins the centroids are sorted into along each axis to
+	// evaluate the surface area heuristic for a split.
+	numBins = 16
+
+	// The cost of visiting a node in the surface area heuristic, relative to
+	// the cost of testing a primitive.
+	traversalCost = 1
+)
+
+// node is a node of the hierarchy. Leaves have a positive count, and hold the
+// primitives prims[start:start+count]. Other nodes have two children: the
+// left one is stored right after the node, and the right one at start.
+type node struct {
+	bounds mgl32.AABB3
+	start  int
+	count  int
+}
+
+// BVH is a bounding volume hierarchy over either triangles, see
+// NewFromTriangles, or boxes, see NewFromAABBs. Primitives are identified by
+// their index in the input: the index of the triangle, or of the box.
+//
+// The hierarchy is a binary tree of boxes, built top-down by splitting the
+// primitives with the surface area heuristic, which minimizes the expected
+// cost of a ray cast. It refers to the slices it was made from rather than
+// copying them, so if they change, say for an animated mesh, Refit must be
+// called before the next query.
+//
+// Queries don't modify the BVH, so they can run concurrently.
+type BVH struct {
+	nodes []node
+	prims []int
+
+	vertices []mgl32.Vec3
+	indices  []int
+	boxes    []mgl32.AABB3
+}
+
+// Hit is the result of a ray cast: the index of the primitive that was hit,
+// the parameter T of the point where it was hit along the ray, and for
+// triangles its barycentric coordinates as returned by Ray3.IntersectTriangle.
+type Hit struct {
+	Index int
+	T     float32
+	U, V  float32
+}
+
+// NewFromTriangles builds a BVH over a triangle mesh, where each three
+// consecutive elements of indices are the indices in vertices of the corners
+// of a triangle, as returned by mgl32.ConvexHull3D. If indices is nil, each
+// three consecutive vertices form a triangle instead.
+func NewFromTriangles(vertices []mgl32.Vec3, indices []int) *BVH {
+	b := &BVH{vertices: vertices, indices: indices}
+	b.build()
+	return b
+}
+
+// NewFromAABBs builds a BVH over a set of boxes, for instance the bounds of
+// the objects in a scene. Empty boxes are never found by queries.
+func NewFromAABBs(boxes []mgl32.AABB3) *BVH {
+	b := &BVH{boxes: boxes}
+	b.build()
+	return b
+}
+
+// Len returns the number of primitives in the BVH.
+func (b *BVH) Len() int {
+	if b.boxes != nil {
+		return len(b.boxes)
+	}
+	if b.indices != nil {
+		return len(b.indices) / 3
+	}
+	return len(b.vertices) / 3
+}
+
+// Bounds returns the box around all of the primitives.
+func (b *BVH) Bounds() mgl32.AABB3 {
+	if len(b.nodes) == 0 {
+		return mgl32.EmptyAABB3()
+	}
+	return b.nodes[0].bounds
+}
+
+// triangle returns the corners of the triangle i.
+func (b *BVH) triangle(i int) (v0, v1, v2 mgl32.Vec3) {
+	if b.indices == nil {
+		return b.vertices[3*i], b.vertices[3*i+1], b.vertices[3*i+2]
+	}
+	return b.vertices[b.indices[3*i]], b.vertices[b.indices[3*i+1]], b.vertices[b.indices[3*i+2]]
+}
+
+// primBounds returns the box around the primitive i.
+func (b *BVH) primBounds(i int) mgl32.AABB3 {
+	if b.boxes != nil {
+		return b.boxes[i]
+	}
+	v0, v1, v2 := b.triangle(i)
+	box := mgl32.AABB3{Min: v0, Max: v0}
+	return box.ExtendPoint(v1).ExtendPoint(v2)
+}
+
+// build builds the hierarchy from scratch.
+func (b *BVH) build() {
+	n := b.Len()
+	b.nodes = make([]node, 0, 2*n/minLeafSize+1)
+	b.prims = make([]int, n)
+	if n == 0 {
+		return
+	}
+
+	prims := make([]buildPrim, n)
+	total := mgl32.EmptyAABB3()
+	for i := range prims {
+		box := b.primBounds(i)
+		prims[i] = buildPrim{box, box.Center(), i}
+		total = total.Union(box)
+	}
+
+	// Empty boxes have no center, so they are put in the middle of the others
+	// where they don't get in the way
+	var middle mgl32.Vec3
+	if !total.IsEmpty() {
+		middle = total.Center()
+	}
+	for i := range prims {
+		if prims[i].bounds.IsEmpty() {
+			prims[i].centroid = middle
+		}
+	}
+
+	b.buildNode(0, n, prims)
+	for i := range prims {
+		b.prims[i] = prims[i].index
+	}
+}
+
+// buildPrim is a primitive while the hierarchy is built. The primitives are
+// moved around together with their bounds rather than looking those up by
+// index, which keeps the primitives of each node close in memory.
+type buildPrim struct {
+	bounds   mgl32.AABB3
+	centroid mgl32.Vec3
+	index    int
+}
+
+// buildNode adds the subtree over prims[start:end] to the hierarchy, and
+// returns the index of its root. It reorders prims[start:end] to match.
+func (b *BVH) buildNode(start, end int, prims []buildPrim) int {
+	index := len(b.nodes)
+	b.nodes = append(b.nodes, node{})
+
+	box, centroidBox := mgl32.EmptyAABB3(), mgl32.EmptyAABB3()
+	for i := range prims[start:end] {
+		p := &prims[start+i]
+		grow(&box, &p.bounds)
+		centroidBox = centroidBox.ExtendPoint(p.centroid)
+	}
+	count := end - start
+	leaf := node{bounds: box, start: start, count: count}
+	if count <= minLeafSize {
+		b.nodes[index] = leaf
+		return index
+	}
+
+	// Sort the centroids into bins along each axis, and find the boundary
+	// between bins with the lowest cost
+	type bin struct {
+		bounds mgl32.AABB3
+		count  int
+	}
+	bestAxis, bestSplit := -1, 0
+	bestCost := float32(count)
+	if count > maxLeafSize {
+		bestCost = mgl32.InfPos
+	}
+	extent := centroidBox.Size()
+	area := box.SurfaceArea()
+	// Small nodes don't need as many bins, which saves most of the work of
+	// building the bottom of the tree
+	bins := numBins
+	if count < bins {
+		bins = count
+	}
+	for axis := 0; axis < 3; axis++ {
+		if extent[axis] <= 0 {
+			continue
+		}
+
+		var binned [numBins]bin
+		for i := range binned[:bins] {
+			binned[i].bounds = mgl32.EmptyAABB3()
+		}
+		scale := float32(bins) / extent[axis]
+		for i := range prims[start:end] {
+			p := &prims[start+i]
+			bin := &binned[binIndex(p.centroid[axis], centroidBox.Min[axis], scale, bins)]
+			grow(&bin.bounds, &p.bounds)
+			bin.count++
+		}
+
+		// The cost of the left side of each split, then the total cost as the
+		// right side is swept in the other direction
+		var leftCost [numBins - 1]float32
+		left, leftCount := mgl32.EmptyAABB3(), 0
+		for k := 0; k < bins-1; k++ {
+			grow(&left, &binned[k].bounds)
+			leftCount += binned[k].count
+			leftCost[k] = left.SurfaceArea() * float32(leftCount)
+		}
+		right, rightCount := mgl32.EmptyAABB3(), 0
+		for k := bins - 1; k > 0; k-- {
+			grow(&right, &binned[k].bounds)
+			rightCount += binned[k].count
+			if rightCount == 0 || rightCount == count {
+				continue
+			}
+			cost := traversalCost + (leftCost[k-1]+right.SurfaceArea()*float32(rightCount))/area
+			if area == 0 {
+				cost = float32(traversalCost + count)
+			}
+			if cost < bestCost {
+				bestAxis, bestSplit, bestCost = axis, k, cost
+			}
+		}
+	}
+
+	mid := start + count/2
+	if bestAxis >= 0 {
+		// Partition the primitives by their bin
+		scale := float32(bins) / extent[bestAxis]
+		i, j := start, end-1
+		for i <= j {
+			if binIndex(prims[i].centroid[bestAxis], centroidBox.Min[bestAxis], scale, bins) < bestSplit {
+				i++
+			} else {
+				prims[i], prims[j] = prims[j], prims[i]
+				j--
+			}
+		}
+		mid = i
+	} else if count <= maxLeafSize {
+		b.nodes[index] = leaf
+		return index
+	}
+	// Otherwise, all centroids are in the same place, and the primitives are
+	// split in half arbitrarily
+
+	b.buildNode(start, mid, prims)
+	right := b.buildNode(mid, end, prims)
+	b.nodes[index] = node{bounds: box, start: right}
+	return index
+}
+
+// grow extends box to contain other, like AABB3.Union, in place. Building the
+// hierarchy spends most of its time here.
+func grow(box, other *mgl32.AABB3) {
+	for i := 0; i < 3; i++ {
+		if other.Min[i] < box.Min[i] {
+			box.Min[i] = other.Min[i]
+		}
+		if other.Max[i] > box.Max[i] {
+			box.Max[i] = other.Max[i]
+		}
+	}
+}
+
+// binIndex returns which of the bins along an axis a centroid at x falls
+// into.
+func binIndex(x, min, scale float32, bins int) int {
+	k := int((x - min) * scale)
+	if k >= bins {
+		k = bins - 1
+	}
+	return k
+}
+
+// Refit updates the boxes of the hierarchy after the vertices or boxes it was
+// built from have changed, while keeping its structure. This is much faster
+// than building a new BVH, but the hierarchy gets less efficient as the
+// primitives move away from where they were when it was built, so it's best
+// suited to deformations such as animated meshes. The number of primitives
+// must not change.
+func (b *BVH) Refit() {
+	// Children are always stored after their parent
+	for i := len(b.nodes) - 1; i >= 0; i-- {
+		n := &b.nodes[i]
+		if n.count == 0 {
+			n.bounds = b.nodes[i+1].bounds.Union(b.nodes[n.start].bounds)
+			continue
+		}
+		n.bounds = mgl32.EmptyAABB3()
+		for _, p := range b.prims[n.start : n.start+n.count] {
+			n.bounds = n.bounds.Union(b.primBounds(p))
+		}
+	}
+}
@@ -0,0 +1,407 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bvh
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func randVec3(r *rand.Rand, scale float32) mgl32.Vec3 {
+	return mgl32.Vec3{
+		(r.Float32()*2 - 1) * scale,
+		(r.Float32()*2 - 1) * scale,
+		(r.Float32()*2 - 1) * scale,
+	}
+}
+
+// randTriangles returns a soup of n small random triangles in a cube.
+func randTriangles(r *rand.Rand, n int) []mgl32.Vec3 {
+	vertices := make([]mgl32.Vec3, 0, 3*n)
+	for i := 0; i < n; i++ {
+		c := randVec3(r, 10)
+		vertices = append(vertices, c.Add(randVec3(r, 1)), c.Add(randVec3(r, 1)), c.Add(randVec3(r, 1)))
+	}
+	return vertices
+}
+
+// randBoxes returns n random boxes in a cube, a few of which are empty.
+func randBoxes(r *rand.Rand, n int) []mgl32.AABB3 {
+	boxes := make([]mgl32.AABB3, n)
+	for i := range boxes {
+		if i%50 == 0 {
+			boxes[i] = mgl32.EmptyAABB3()
+			continue
+		}
+		c, size := randVec3(r, 10), randVec3(r, 1)
+		boxes[i] = mgl32.AABB3{Min: c, Max: c}.ExtendPoint(c.Add(size))
+	}
+	return boxes
+}
+
+// bruteForce has the same queries as BVH, testing every primitive.
+type bruteForce struct {
+	b *BVH
+}
+
+func (f bruteForce) rayCast(r mgl32.Ray3, tMax float32) (hit Hit, ok bool) {
+	for i := 0; i < f.b.Len(); i++ {
+		if h, in := f.b.intersectRay(r, i, tMax); in {
+			hit, ok, tMax = h, true, h.T
+		}
+	}
+	return hit, ok
+}
+
+func (f bruteForce) overlap(test func(i int) bool) []int {
+	var dst []int
+	for i := 0; i < f.b.Len(); i++ {
+		if f.b.boxes != nil && f.b.boxes[i].IsEmpty() {
+			continue
+		}
+		if test(i) {
+			dst = append(dst, i)
+		}
+	}
+	return dst
+}
+
+func (f bruteForce) nearestDist(p mgl32.Vec3) float32 {
+	best := mgl32.InfPos
+	for i := 0; i < f.b.Len(); i++ {
+		var q mgl32.Vec3
+		if f.b.boxes != nil {
+			if f.b.boxes[i].IsEmpty() {
+				continue
+			}
+			q = f.b.boxes[i].ClosestPoint(p)
+		} else {
+			v0, v1, v2 := f.b.triangle(i)
+			q = closestPointTriangle(p, v0, v1, v2)
+		}
+		if d := q.Sub(p).Len(); d < best {
+			best = d
+		}
+	}
+	return best
+}
+
+func sameSet(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]int(nil), a...), append([]int(nil), b...)
+	sort.Ints(a)
+	sort.Ints(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkTree checks that each node contains its primitives and children, and
+// that each primitive is in exactly one leaf.
+func checkTree(t *testing.T, b *BVH) {
+	seen := make([]int, b.Len())
+	for i, n := range b.nodes {
+		if n.count == 0 {
+			for _, c := range []int{i + 1, n.start} {
+				if c <= i || !n.bounds.Contains(b.nodes[c].bounds) {
+					t.Fatalf("Node %d doesn't contain its child %d", i, c)
+				}
+			}
+			continue
+		}
+		for _, p := range b.prims[n.start : n.start+n.count] {
+			seen[p]++
+			if !n.bounds.Contains(b.primBounds(p)) {
+				t.Fatalf("Node %d doesn't contain its primitive %d", i, p)
+			}
+		}
+	}
+	for p, count := range seen {
+		if count != 1 {
+			t.Fatalf("Primitive %d is in %d leaves", p, count)
+		}
+	}
+}
+
+func checkQueries(t *testing.T, r *rand.Rand, b *BVH) {
+	f := bruteForce{b}
+
+	for i := 0; i < 200; i++ {
+		ray := mgl32.Ray3{Origin: randVec3(r, 15), Dir: randVec3(r, 1)}
+		tMax := float32(mgl32.InfPos)
+		if i%4 == 0 {
+			tMax = 5
+		}
+		expected, expectedOk := f.rayCast(ray, tMax)
+		if hit, ok := b.RayCast(ray, tMax); ok != expectedOk || ok && (hit.Index != expected.Index || hit.T != expected.T) {
+			t.Errorf("RayCast(%v, %v) = %v, %v, expected %v, %v", ray, tMax, hit, ok, expected, expectedOk)
+		}
+		if hit, ok := b.RayCastAny(ray, tMax); ok != expectedOk || ok && hit.T > tMax {
+			t.Errorf("RayCastAny(%v, %v) = %v, %v, expected a hit: %v", ray, tMax, hit, ok, expectedOk)
+		} else if ok {
+			if h, in := b.intersectRay(ray, hit.Index, tMax); !in || h != hit {
+				t.Errorf("RayCastAny(%v, %v) = %v, which isn't a hit", ray, tMax, hit)
+			}
+		}
+
+		box := mgl32.AABB3{Min: randVec3(r, 12), Max: randVec3(r, 12)}
+		box = mgl32.AABB3{Min: box.Min, Max: box.Min}.ExtendPoint(box.Max)
+		obb := mgl32.OBBFromAABB(box)
+		expectedPrims := f.overlap(func(i int) bool {
+			if b.boxes != nil {
+				return box.Intersects(b.boxes[i])
+			}
+			return obb.IntersectsTriangle(b.triangle(i))
+		})
+		if prims := b.OverlapAABB(nil, box); !sameSet(prims, expectedPrims) {
+			t.Errorf("OverlapAABB(%v) = %v, expected %v", box, prims, expectedPrims)
+		}
+
+		s := mgl32.Sphere{Center: randVec3(r, 12), Radius: r.Float32() * 8}
+		expectedPrims = f.overlap(func(i int) bool {
+			if b.boxes != nil {
+				return b.boxes[i].DistSqr(s.Center) <= s.Radius*s.Radius
+			}
+			v0, v1, v2 := b.triangle(i)
+			return closestPointTriangle(s.Center, v0, v1, v2).Sub(s.Center).LenSqr() <= s.Radius*s.Radius
+		})
+		if prims := b.OverlapSphere(nil, s); !sameSet(prims, expectedPrims) {
+			t.Errorf("OverlapSphere(%v) = %v, expected %v", s, prims, expectedPrims)
+		}
+
+		eye := randVec3(r, 15)
+		view := mgl32.Perspective(mgl32.DegToRad(60), 1.5, 0.5, 10).Mul4(mgl32.LookAtV(eye, randVec3(r, 5), mgl32.Vec3{0, 1, 0}))
+		frustum := mgl32.ExtractFrustumPlanes(view)
+		expectedPrims = f.overlap(func(i int) bool {
+			if b.boxes != nil {
+				return frustum.ClassifyAABB(b.boxes[i]) != mgl32.Outside
+			}
+			v0, v1, v2 := b.triangle(i)
+			for _, plane := range frustum {
+				if plane.SignedDistance(v0) < 0 && plane.SignedDistance(v1) < 0 && plane.SignedDistance(v2) < 0 {
+					return false
+				}
+			}
+			return true
+		})
+		if prims := b.OverlapFrustum(nil, frustum); !sameSet(prims, expectedPrims) {
+			t.Errorf("OverlapFrustum(%v) = %v, expected %v", frustum, prims, expectedPrims)
+		}
+
+		p := randVec3(r, 15)
+		expectedDist := f.nearestDist(p)
+		index, point, ok := b.Nearest(p, mgl32.InfPos)
+		if !ok || !mgl32.FloatEqualThreshold(point.Sub(p).Len(), expectedDist, 1e-5) {
+			t.Errorf("Nearest(%v) = %v, %v, %v, expected a distance of %v", p, index, point, ok, expectedDist)
+		}
+		if _, _, ok := b.Nearest(p, expectedDist*0.99); ok {
+			t.Errorf("Nearest(%v, %v) found a primitive closer than the closest one", p, expectedDist*0.99)
+		}
+	}
+}
+
+func TestTriangles(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	vertices := randTriangles(r, 2000)
+	soup := NewFromTriangles(vertices, nil)
+	if soup.Len() != 2000 {
+		t.Fatalf("Len() = %d, expected 2000", soup.Len())
+	}
+	checkTree(t, soup)
+	checkQueries(t, r, soup)
+
+	// The same triangles through an index buffer, in reverse order
+	indices := make([]int, len(vertices))
+	for i := range indices {
+		indices[i] = len(vertices) - 1 - i
+	}
+	indexed := NewFromTriangles(vertices, indices)
+	checkTree(t, indexed)
+	checkQueries(t, r, indexed)
+
+	// Move the vertices as an animation would
+	for i := range vertices {
+		v := vertices[i]
+		vertices[i] = mgl32.Vec3{v[0] + float32(math.Sin(float64(v[1]))), v[1] * 1.5, v[2] - v[0]*0.5}
+	}
+	soup.Refit()
+	indexed.Refit()
+	checkTree(t, soup)
+	checkQueries(t, r, soup)
+	checkTree(t, indexed)
+	checkQueries(t, r, indexed)
+}
+
+func TestAABBs(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(2))
+	boxes := randBoxes(r, 1500)
+	b := NewFromAABBs(boxes)
+	checkTree(t, b)
+	checkQueries(t, r, b)
+
+	for i := range boxes {
+		if !boxes[i].IsEmpty() {
+			offset := randVec3(r, 2)
+			boxes[i] = mgl32.AABB3{Min: boxes[i].Min.Add(offset), Max: boxes[i].Max.Add(offset)}
+		}
+	}
+	b.Refit()
+	checkTree(t, b)
+	checkQueries(t, r, b)
+}
+
+func TestDegenerate(t *testing.T) {
+	t.Parallel()
+
+	empty := NewFromTriangles(nil, nil)
+	if !empty.Bounds().IsEmpty() {
+		t.Errorf("Bounds() of an empty BVH = %v", empty.Bounds())
+	}
+	if _, ok := empty.RayCast(mgl32.Ray3{Dir: mgl32.Vec3{1, 0, 0}}, mgl32.InfPos); ok {
+		t.Errorf("RayCast() hit an empty BVH")
+	}
+	if _, _, ok := empty.Nearest(mgl32.Vec3{}, mgl32.InfPos); ok {
+		t.Errorf("Nearest() found a primitive in an empty BVH")
+	}
+	if prims := empty.OverlapSphere(nil, mgl32.Sphere{Radius: 1}); len(prims) != 0 {
+		t.Errorf("OverlapSphere() = %v in an empty BVH", prims)
+	}
+
+	// Many copies of the same triangle have nothing to split them by
+	vertices := make([]mgl32.Vec3, 0, 300)
+	for i := 0; i < 100; i++ {
+		vertices = append(vertices, mgl32.Vec3{0, 0, 0}, mgl32.Vec3{1, 0, 0}, mgl32.Vec3{0, 1, 0})
+	}
+	same := NewFromTriangles(vertices, nil)
+	checkTree(t, same)
+	prims := same.OverlapSphere(nil, mgl32.Sphere{Center: mgl32.Vec3{0.2, 0.2, 1}, Radius: 1.5})
+	if len(prims) != 100 {
+		t.Errorf("OverlapSphere() found %d of 100 identical triangles", len(prims))
+	}
+	ray := mgl32.Ray3{Origin: mgl32.Vec3{0.2, 0.3, 1}, Dir: mgl32.Vec3{0, 0, -1}}
+	if hit, ok := same.RayCast(ray, mgl32.InfPos); !ok || !mgl32.FloatEqual(hit.T, 1) || !mgl32.FloatEqual(hit.U, 0.2) || !mgl32.FloatEqual(hit.V, 0.3) {
+		t.Errorf("RayCast(%v) = %v, %v", ray, hit, ok)
+	}
+}
+
+// bumpyGrid returns a height field over an n by n grid of quads, split into
+// 2n² triangles.
+func bumpyGrid(n int) (vertices []mgl32.Vec3, indices []int) {
+	vertices = make([]mgl32.Vec3, 0, (n+1)*(n+1))
+	for i := 0; i <= n; i++ {
+		for j := 0; j <= n; j++ {
+			x, z := float64(i)/float64(n)*100, float64(j)/float64(n)*100
+			y := 3*math.Sin(x*0.3)*math.Cos(z*0.2) + math.Sin(x*2+z*3)
+			vertices = append(vertices, mgl32.Vec3{float32(x), float32(y), float32(z)})
+		}
+	}
+
+	indices = make([]int, 0, 6*n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			k := i*(n+1) + j
+			indices = append(indices, k, k+1, k+n+1, k+1, k+n+2, k+n+1)
+		}
+	}
+	return vertices, indices
+}
+
+var (
+	benchOnce     sync.Once
+	benchVertices []mgl32.Vec3
+	benchIndices  []int
+	benchBVH      *BVH
+)
+
+// benchMesh returns a mesh of about a million triangles, and a BVH over it.
+func benchMesh() ([]mgl32.Vec3, []int, *BVH) {
+	benchOnce.Do(func() {
+		benchVertices, benchIndices = bumpyGrid(708)
+		benchBVH = NewFromTriangles(benchVertices, benchIndices)
+	})
+	return benchVertices, benchIndices, benchBVH
+}
+
+func BenchmarkBuild(b *testing.B) {
+	vertices, indices, _ := benchMesh()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewFromTriangles(vertices, indices)
+	}
+}
+
+func BenchmarkRefit(b *testing.B) {
+	_, _, bvh := benchMesh()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bvh.Refit()
+	}
+}
+
+func benchRays(n int) []mgl32.Ray3 {
+	r := rand.New(rand.NewSource(1))
+	rays := make([]mgl32.Ray3, n)
+	for i := range rays {
+		origin := mgl32.Vec3{r.Float32() * 100, 20, r.Float32() * 100}
+		rays[i] = mgl32.Ray3{Origin: origin, Dir: mgl32.Vec3{r.Float32() - 0.5, -1, r.Float32() - 0.5}}
+	}
+	return rays
+}
+
+func BenchmarkRayCast(b *testing.B) {
+	_, _, bvh := benchMesh()
+	rays := benchRays(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bvh.RayCast(rays[i%len(rays)], mgl32.InfPos)
+	}
+}
+
+func BenchmarkRayCastAny(b *testing.B) {
+	_, _, bvh := benchMesh()
+	rays := benchRays(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bvh.RayCastAny(rays[i%len(rays)], mgl32.InfPos)
+	}
+}
+
+func BenchmarkNearest(b *testing.B) {
+	_, _, bvh := benchMesh()
+	r := rand.New(rand.NewSource(1))
+	points := make([]mgl32.Vec3, 1024)
+	for i := range points {
+		points[i] = mgl32.Vec3{r.Float32() * 100, r.Float32()*20 - 10, r.Float32() * 100}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bvh.Nearest(points[i%len(points)], mgl32.InfPos)
+	}
+}
+
+func BenchmarkOverlapSphere(b *testing.B) {
+	_, _, bvh := benchMesh()
+	r := rand.New(rand.NewSource(1))
+	var dst []int
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		center := mgl32.Vec3{r.Float32() * 100, 0, r.Float32() * 100}
+		dst = bvh.OverlapSphere(dst[:0], mgl32.Sphere{Center: center, Radius: 1})
+	}
+}
@@ -0,0 +1,371 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bvh
+
+import (
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// The queries walk the tree with an explicit stack, which starts out with
+// room for this many nodes. This is enough for the tree of any reasonably
+// distributed set of primitives without allocating.
+const stackSize = 64
+
+// stackEntry is a node waiting to be visited on the stack of a query, with
+// the distance at which the query reaches its box.
+type stackEntry struct {
+	index int
+	dist  float32
+}
+
+// rayBox intersects the ray from origin, with the inverse invDir of its
+// direction, with the box, and returns the parameter at which it enters it.
+// It misses if it doesn't enter the box before tMax.
+func rayBox(origin, invDir mgl32.Vec3, box mgl32.AABB3, tMax float32) (tNear float32, ok bool) {
+	tFar := tMax
+	for i := 0; i < 3; i++ {
+		t1 := (box.Min[i] - origin[i]) * invDir[i]
+		t2 := (box.Max[i] - origin[i]) * invDir[i]
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		// Comparisons with NaN are false, which leaves rays in the plane of a
+		// face unclipped
+		if t1 > tNear {
+			tNear = t1
+		}
+		if t2 < tFar {
+			tFar = t2
+		}
+	}
+
+	return tNear, tNear <= tFar
+}
+
+// intersectRay intersects the ray with the primitive i, and returns the
+// resulting hit if it is before tMax.
+func (b *BVH) intersectRay(r mgl32.Ray3, i int, tMax float32) (Hit, bool) {
+	if b.boxes != nil {
+		if b.boxes[i].IsEmpty() {
+			return Hit{}, false
+		}
+		t, _, ok := r.IntersectAABB(b.boxes[i])
+		return Hit{Index: i, T: t}, ok && t <= tMax
+	}
+
+	v0, v1, v2 := b.triangle(i)
+	t, u, v, ok := r.IntersectTriangle(v0, v1, v2)
+	return Hit{i, t, u, v}, ok && t <= tMax
+}
+
+// RayCast finds the first primitive hit by the ray r, up to the parameter
+// tMax along it, which may be mgl32.InfPos. For boxes, the parameter of the
+// hit is where the ray enters the box, or 0 if it starts inside of it. If
+// nothing is hit, ok is false.
+//
+// The children of each node are visited nearest first, and those farther
+// away than the closest hit found so far are skipped.
+func (b *BVH) RayCast(r mgl32.Ray3, tMax float32) (hit Hit, ok bool) {
+	return b.rayCast(r, tMax, false)
+}
+
+// RayCastAny finds any primitive hit by the ray r up to the parameter tMax
+// along it, not necessarily the first one. It stops at the first hit it
+// finds, which makes it faster than RayCast for occlusion tests such as
+// shadow rays.
+func (b *BVH) RayCastAny(r mgl32.Ray3, tMax float32) (hit Hit, ok bool) {
+	return b.rayCast(r, tMax, true)
+}
+
+func (b *BVH) rayCast(r mgl32.Ray3, tMax float32, anyHit bool) (hit Hit, ok bool) {
+	if len(b.nodes) == 0 {
+		return Hit{}, false
+	}
+
+	invDir := mgl32.Vec3{1 / r.Dir[0], 1 / r.Dir[1], 1 / r.Dir[2]}
+	t, in := rayBox(r.Origin, invDir, b.nodes[0].bounds, tMax)
+	if !in {
+		return Hit{}, false
+	}
+
+	var buf [stackSize]stackEntry
+	stack := append(buf[:0], stackEntry{0, t})
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if top.dist > tMax {
+			continue
+		}
+
+		n := &b.nodes[top.index]
+		if n.count > 0 {
+			for _, p := range b.prims[n.start : n.start+n.count] {
+				if h, in := b.intersectRay(r, p, tMax); in {
+					hit, ok, tMax = h, true, h.T
+					if anyHit {
+						return hit, ok
+					}
+				}
+			}
+			continue
+		}
+
+		// Push the farther child first, so the nearer one is visited next
+		left := stackEntry{index: top.index + 1}
+		right := stackEntry{index: n.start}
+		var inLeft, inRight bool
+		left.dist, inLeft = rayBox(r.Origin, invDir, b.nodes[left.index].bounds, tMax)
+		right.dist, inRight = rayBox(r.Origin, invDir, b.nodes[right.index].bounds, tMax)
+		if inLeft && inRight && left.dist < right.dist {
+			left, right = right, left
+		}
+		if inLeft {
+			stack = append(stack, left)
+		}
+		if inRight {
+			stack = append(stack, right)
+		}
+	}
+
+	return hit, ok
+}
+
+// Nearest finds the primitive closest to p, and the point of it that is
+// closest to p, among those less than maxDist away from it. maxDist may be
+// mgl32.InfPos. If there are none, ok is false.
+func (b *BVH) Nearest(p mgl32.Vec3, maxDist float32) (index int, point mgl32.Vec3, ok bool) {
+	if len(b.nodes) == 0 {
+		return 0, mgl32.Vec3{}, false
+	}
+
+	best := maxDist * maxDist
+	var buf [stackSize]stackEntry
+	stack := append(buf[:0], stackEntry{0, b.nodes[0].bounds.DistSqr(p)})
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if top.dist >= best {
+			continue
+		}
+
+		n := &b.nodes[top.index]
+		if n.count > 0 {
+			for _, i := range b.prims[n.start : n.start+n.count] {
+				var q mgl32.Vec3
+				if b.boxes != nil {
+					if b.boxes[i].IsEmpty() {
+						continue
+					}
+					q = b.boxes[i].ClosestPoint(p)
+				} else {
+					v0, v1, v2 := b.triangle(i)
+					q = closestPointTriangle(p, v0, v1, v2)
+				}
+				if d := q.Sub(p).LenSqr(); d < best {
+					index, point, ok, best = i, q, true, d
+				}
+			}
+			continue
+		}
+
+		// Push the farther child first, so the nearer one is visited next
+		left := stackEntry{top.index + 1, b.nodes[top.index+1].bounds.DistSqr(p)}
+		right := stackEntry{n.start, b.nodes[n.start].bounds.DistSqr(p)}
+		if left.dist < right.dist {
+			left, right = right, left
+		}
+		stack = append(stack, left, right)
+	}
+
+	return index, point, ok
+}
+
+// closestPointTriangle returns the point of the triangle v0, v1, v2 that is
+// closest to p, by finding the Voronoi region of the triangle p is in, as in
+// Ericson's "Real-Time Collision Detection" (2005).
+func closestPointTriangle(p, v0, v1, v2 mgl32.Vec3) mgl32.Vec3 {
+	e1, e2 := v1.Sub(v0), v2.Sub(v0)
+	d1, d2 := e1.Dot(p.Sub(v0)), e2.Dot(p.Sub(v0))
+	if d1 <= 0 && d2 <= 0 {
+		return v0
+	}
+
+	d3, d4 := e1.Dot(p.Sub(v1)), e2.Dot(p.Sub(v1))
+	if d3 >= 0 && d4 <= d3 {
+		return v1
+	}
+
+	vc := d1*d4 - d3*d2
+	if vc <= 0 && d1 >= 0 && d3 <= 0 {
+		return v0.Add(e1.Mul(d1 / (d1 - d3)))
+	}
+
+	d5, d6 := e1.Dot(p.Sub(v2)), e2.Dot(p.Sub(v2))
+	if d6 >= 0 && d5 <= d6 {
+		return v2
+	}
+
+	vb := d5*d2 - d1*d6
+	if vb <= 0 && d2 >= 0 && d6 <= 0 {
+		return v0.Add(e2.Mul(d2 / (d2 - d6)))
+	}
+
+	va := d3*d6 - d5*d4
+	if va <= 0 && d4-d3 >= 0 && d5-d6 >= 0 {
+		return v1.Add(v2.Sub(v1).Mul((d4 - d3) / ((d4 - d3) + (d5 - d6))))
+	}
+
+	sum := va + vb + vc
+	if sum == 0 {
+		// A degenerate triangle, where every region above was missed due to
+		// rounding errors
+		return v0
+	}
+	return v0.Add(e1.Mul(vb / sum)).Add(e2.Mul(vc / sum))
+}
+
+// overlap appends to dst the primitives in the hierarchy for which test is
+// true, only looking at those in nodes that classify doesn't find Outside.
+// The primitives of the nodes that classify finds Inside are all taken,
+// without calling test.
+func (b *BVH) overlap(dst []int, classify func(mgl32.AABB3) mgl32.Containment, test func(i int) bool) []int {
+	if len(b.nodes) == 0 {
+		return dst
+	}
+
+	var buf [stackSize]int
+	stack := append(buf[:0], 0)
+	for len(stack) > 0 {
+		index := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		n := &b.nodes[index]
+		switch classify(n.bounds) {
+		case mgl32.Outside:
+			continue
+		case mgl32.Inside:
+			dst = b.appendAll(dst, index)
+			continue
+		}
+
+		if n.count == 0 {
+			stack = append(stack, n.start, index+1)
+			continue
+		}
+		for _, p := range b.prims[n.start : n.start+n.count] {
+			if test(p) {
+				dst = append(dst, p)
+			}
+		}
+	}
+
+	return dst
+}
+
+// appendAll appends the primitives in the subtree of the node index to dst.
+// Empty boxes are left out.
+func (b *BVH) appendAll(dst []int, index int) []int {
+	// The nodes of a subtree are stored contiguously after its root, so its
+	// primitives are too
+	last := index
+	for b.nodes[last].count == 0 {
+		last = b.nodes[last].start
+	}
+	first := index
+	for b.nodes[first].count == 0 {
+		first++
+	}
+
+	start, end := b.nodes[first].start, b.nodes[last].start+b.nodes[last].count
+	for _, p := range b.prims[start:end] {
+		if b.boxes == nil || !b.boxes[p].IsEmpty() {
+			dst = append(dst, p)
+		}
+	}
+
+	return dst
+}
+
+// OverlapAABB appends to dst the indices of the primitives that overlap or
+// touch the box, in no particular order, and returns the resulting slice.
+// Triangles are tested exactly with mgl32.OBB.IntersectsTriangle.
+func (b *BVH) OverlapAABB(dst []int, box mgl32.AABB3) []int {
+	obb := mgl32.OBBFromAABB(box)
+	classify := func(bounds mgl32.AABB3) mgl32.Containment {
+		if !box.Intersects(bounds) {
+			return mgl32.Outside
+		}
+		if box.Contains(bounds) {
+			return mgl32.Inside
+		}
+		return mgl32.Intersecting
+	}
+	test := func(i int) bool {
+		if b.boxes != nil {
+			return !b.boxes[i].IsEmpty() && box.Intersects(b.boxes[i])
+		}
+		return obb.IntersectsTriangle(b.triangle(i))
+	}
+
+	return b.overlap(dst, classify, test)
+}
+
+// OverlapSphere appends to dst the indices of the primitives that overlap or
+// touch the sphere, in no particular order, and returns the resulting slice.
+func (b *BVH) OverlapSphere(dst []int, s mgl32.Sphere) []int {
+	r2 := s.Radius * s.Radius
+	classify := func(bounds mgl32.AABB3) mgl32.Containment {
+		if s.IsEmpty() || bounds.DistSqr(s.Center) > r2 {
+			return mgl32.Outside
+		}
+
+		// Check the corner of the box farthest from the center
+		var far mgl32.Vec3
+		for i := range far {
+			far[i] = mgl32.Abs(bounds.Min[i] - s.Center[i])
+			if d := mgl32.Abs(bounds.Max[i] - s.Center[i]); d > far[i] {
+				far[i] = d
+			}
+		}
+		if far.LenSqr() <= r2 {
+			return mgl32.Inside
+		}
+		return mgl32.Intersecting
+	}
+	test := func(i int) bool {
+		if b.boxes != nil {
+			return !b.boxes[i].IsEmpty() && b.boxes[i].DistSqr(s.Center) <= r2
+		}
+		v0, v1, v2 := b.triangle(i)
+		return closestPointTriangle(s.Center, v0, v1, v2).Sub(s.Center).LenSqr() <= r2
+	}
+
+	return b.overlap(dst, classify, test)
+}
+
+// OverlapFrustum appends to dst the indices of the primitives that may be
+// visible in the frustum f, in no particular order, and returns the
+// resulting slice.
+//
+// Like mgl32.FrustumPlanes.ClassifyAABB this is a conservative test, as used
+// for frustum culling: triangles are only left out if all of their corners
+// are outside of the same plane, and boxes if ClassifyAABB finds them
+// Outside.
+func (b *BVH) OverlapFrustum(dst []int, f mgl32.FrustumPlanes) []int {
+	test := func(i int) bool {
+		if b.boxes != nil {
+			return f.ClassifyAABB(b.boxes[i]) != mgl32.Outside
+		}
+		v0, v1, v2 := b.triangle(i)
+		for _, plane := range f {
+			if plane.SignedDistance(v0) < 0 && plane.SignedDistance(v1) < 0 && plane.SignedDistance(v2) < 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	return b.overlap(dst, f.ClassifyAABB, test)
+}
@@ -0,0 +1,150 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// Cholesky is the Cholesky decomposition of a symmetric positive definite
+// MatMxN A. That is, A = L*L^T where L is lower triangular with a positive
+// diagonal.
+//
+// It is roughly twice as fast to compute as an LU decomposition, and
+// numerically stable without pivoting, which makes it the method of choice for
+// matrices known to be positive definite, such as the normal equations
+// A^T*A of a least squares problem or covariance matrices.
+type Cholesky struct {
+	l *MatMxN
+}
+
+// Cholesky computes the Cholesky decomposition of mat and stores it in dst,
+// reusing its memory if possible. If dst is nil, a new Cholesky will be
+// allocated. The value returned is dst.
+//
+// Only the lower triangle of mat is read; mat is assumed to be symmetric. If mat
+// is nil a NilMatrixError is returned, if it is not square a
+// RectangularMatrixError, and if it is not positive definite a
+// NotPositiveDefiniteError.
+func (mat *MatMxN) Cholesky(dst *Cholesky) (*Cholesky, error) {
+	if mat == nil {
+		return nil, NilMatrixError{}
+	}
+	if mat.m != mat.n {
+		return nil, RectangularMatrixError{}
+	}
+
+	if dst == nil {
+		dst = &Cholesky{}
+	}
+	n := mat.m
+	dst.l = dst.l.Reshape(n, n)
+	l := dst.l
+
+	for j := 0; j < n; j++ {
+		d := mat.At(j, j)
+		for k := 0; k < j; k++ {
+			d -= l.At(j, k) * l.At(j, k)
+		}
+		if d <= 0 {
+			return nil, NotPositiveDefiniteError{}
+		}
+		d = float32(math.Sqrt(float64(d)))
+		l.Set(j, j, d)
+
+		for i := 0; i < j; i++ {
+			l.Set(i, j, 0)
+		}
+		for i := j + 1; i < n; i++ {
+			s := mat.At(i, j)
+			for k := 0; k < j; k++ {
+				s -= l.At(i, k) * l.At(j, k)
+			}
+			l.Set(i, j, s/d)
+		}
+	}
+
+	return dst, nil
+}
+
+// Size returns the number of rows (and columns) of the decomposed matrix.
+func (ch *Cholesky) Size() int {
+	return ch.l.m
+}
+
+// L stores the lower triangular factor of the decomposition in dst, which is
+// Reshaped as necessary, and returns it.
+func (ch *Cholesky) L(dst *MatMxN) *MatMxN {
+	dst = dst.Reshape(ch.l.m, ch.l.n)
+	CopyMatMN(dst, ch.l)
+
+	return dst
+}
+
+// Det returns the determinant of the decomposed matrix, which is the square
+// of the product of the diagonal of L.
+func (ch *Cholesky) Det() float32 {
+	var det float32 = 1
+	for i := 0; i < ch.l.m; i++ {
+		det *= ch.l.At(i, i)
+	}
+
+	return det * det
+}
+
+// Solve solves the linear system Ax = b for x, where A is the decomposed
+// matrix, and stores the result in dst, which is Resized as necessary. Dst may
+// be b.
+//
+// If b is nil or its size does not match the matrix, this returns a
+// DimensionMismatchError.
+func (ch *Cholesky) Solve(dst, b *VecN) (*VecN, error) {
+	n := ch.l.m
+	if b == nil || len(b.vec) != n {
+		return nil, DimensionMismatchError{}
+	}
+
+	dst = dst.Resize(n)
+	copy(dst.vec, b.vec)
+	ch.solveInPlace(dst.vec)
+
+	return dst, nil
+}
+
+// solveInPlace performs forward substitution with L and back substitution
+// with L^T on x.
+func (ch *Cholesky) solveInPlace(x []float32) {
+	l, n := ch.l, ch.l.m
+
+	// Ly = b
+	for i := 0; i < n; i++ {
+		for k := 0; k < i; k++ {
+			x[i] -= l.At(i, k) * x[k]
+		}
+		x[i] /= l.At(i, i)
+	}
+
+	// L^T x = y
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			x[i] -= l.At(k, i) * x[k]
+		}
+		x[i] /= l.At(i, i)
+	}
+}
+
+// Inv stores the inverse of the decomposed matrix in dst, which is Reshaped as
+// necessary, and returns it. The inverse is found by solving for every column
+// of the identity matrix.
+func (ch *Cholesky) Inv(dst *MatMxN) *MatMxN {
+	n := ch.l.m
+	dst = IdentN(dst, n)
+
+	for j := 0; j < n; j++ {
+		ch.solveInPlace(dst.dat[j*n : (j+1)*n])
+	}
+
+	return dst
+}
@@ -0,0 +1,67 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestCholesky(t *testing.T) {
+	m := NewMatrixFromData([]float32{
+		4, 12, -16,
+		12, 37, -43,
+		-16, -43, 98,
+	}, 3, 3)
+
+	ch, err := m.Cholesky(nil)
+	if err != nil {
+		t.Fatalf("Cholesky returned error: %v", err)
+	}
+
+	expected := NewMatrixFromData([]float32{
+		2, 6, -8,
+		0, 1, 5,
+		0, 0, 3,
+	}, 3, 3)
+	l := ch.L(nil)
+	if !l.ApproxEqualThreshold(expected, 1e-5) {
+		t.Errorf("L is %v, expected %v", l, expected)
+	}
+	if prod := l.MulMxN(nil, l.Transpose(nil)); !prod.ApproxEqualThreshold(m, 1e-5) {
+		t.Errorf("L * L^T is %v, expected %v", prod, m)
+	}
+	if det := ch.Det(); !FloatEqualThreshold(det, 36, 1e-5) {
+		t.Errorf("Det is %v, expected 36", det)
+	}
+
+	x := NewVecNFromData([]float32{1, -2, 3})
+	b := m.MulNx1(nil, x)
+	if result, err := ch.Solve(nil, b); err != nil || !result.ApproxEqualThreshold(x, 1e-4) {
+		t.Errorf("Solve gives %v (err %v), expected %v", result.Raw(), err, x.Raw())
+	}
+
+	near := func(a, b float32) bool { return Abs(a-b) < 1e-4 }
+	if prod := m.MulMxN(nil, ch.Inv(nil)); !prod.ApproxEqualFunc(IdentN(nil, 3), near) {
+		t.Errorf("Product of matrix and its inverse is %v, expected identity", prod)
+	}
+}
+
+func TestCholeskyErrors(t *testing.T) {
+	var nilMat *MatMxN
+	if _, err := nilMat.Cholesky(nil); err != (NilMatrixError{}) {
+		t.Errorf("Cholesky of nil matrix returned %v, expected NilMatrixError", err)
+	}
+	if _, err := NewMatrix(3, 2).Cholesky(nil); err != (RectangularMatrixError{}) {
+		t.Errorf("Cholesky of rectangular matrix returned %v, expected RectangularMatrixError", err)
+	}
+	indefinite := NewMatrixFromData([]float32{1, 2, 2, 1}, 2, 2)
+	if _, err := indefinite.Cholesky(nil); err != (NotPositiveDefiniteError{}) {
+		t.Errorf("Cholesky of indefinite matrix returned %v, expected NotPositiveDefiniteError", err)
+	}
+	ch, _ := IdentN(nil, 2).Cholesky(nil)
+	if _, err := ch.Solve(nil, NewVecN(3)); err != (DimensionMismatchError{}) {
+		t.Errorf("Solve with wrong sized vector returned %v, expected DimensionMismatchError", err)
+	}
+}
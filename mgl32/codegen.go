@@ -9,7 +9,8 @@
 // used with go generate; Also makes mgl64 from mgl32.
 // See the invocation in mgl32/util.go for details.
 // To use it, just run "go generate github.com/go-gl/mathgl/mgl32"
-// (or "go generate" in mgl32 directory).
+// (or "go generate" in mgl32 directory). This also rewrites the generated
+// vector and matrix types into the generic package mgl.
 
 package main
 
@@ -17,6 +18,10 @@ import (
 	"bytes"
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -30,6 +35,12 @@ type Context struct {
 	TemplateName string
 }
 
+// Swizzle is a GLSL-style swizzle of a vector, like XZY.
+type Swizzle struct {
+	Name    string
+	Indices []int
+}
+
 type MatrixIter struct {
 	M     int // row
 	N     int // column
@@ -43,12 +54,16 @@ var mgl64RewriteRules = []string{
 	"a.Float32 -> a.Float64",
 	"math.MaxFloat32 -> math.MaxFloat64",
 	"math.SmallestNonzeroFloat32 -> math.SmallestNonzeroFloat64",
+	"math.Nextafter32 -> math.Nextafter",
+	"binary.LittleEndian.PutUint32(a, math.Float32bits(b)) -> binary.LittleEndian.PutUint64(a, math.Float64bits(b))",
+	"math.Float32frombits(binary.LittleEndian.Uint32(a)) -> math.Float64frombits(binary.LittleEndian.Uint64(a))",
 }
 
 func main() {
 	flag.Usage = func() {
 		fmt.Println("Usage: codegen -template file.tmpl -output file.go")
 		fmt.Println("Usage: codegen -mgl64 [-dir ../mgl64]")
+		fmt.Println("Usage: codegen -generic [-gendir ../mgl]")
 		flag.PrintDefaults()
 	}
 
@@ -56,6 +71,8 @@ func main() {
 	oPath := flag.String("output", "file.go", "output path")
 	mgl64 := flag.Bool("mgl64", false, "make mgl64")
 	mgl64Path := flag.String("dir", "../mgl64", "path to mgl64 location")
+	generic := flag.Bool("generic", false, "make the generic vector and matrix types")
+	genericPath := flag.String("gendir", "../mgl", "path to generic package location")
 
 	flag.Parse()
 	if flag.NArg() > 0 || flag.NFlag() == 0 {
@@ -67,6 +84,10 @@ func main() {
 		genMgl64(*mgl64Path)
 		return
 	}
+	if *generic {
+		genGeneric(*genericPath)
+		return
+	}
 
 	tmpl := template.New("").Delims("<<", ">>").Funcs(template.FuncMap{
 		"typename":    typenameHelper,
@@ -78,6 +99,8 @@ func main() {
 		"repeat":      repeatHelper,
 		"add":         addHelper,
 		"mul":         mulHelper,
+		"lower":       strings.ToLower,
+		"swizzles":    swizzleHelper,
 	})
 	tmpl = template.Must(tmpl.ParseFiles(*tmplPath))
 	tmplName := filepath.Base(*tmplPath)
@@ -114,6 +137,10 @@ func genMgl64(destPath string) {
 		if !strings.HasSuffix(source, ".go") || info.Name() == "codegen.go" {
 			return nil
 		}
+		if strings.HasSuffix(source, "_amd64.go") || strings.HasSuffix(source, "_amd64_test.go") {
+			// The assembly is specific to float32, mgl64 always uses pure Go
+			return nil
+		}
 		if !info.Mode().IsRegular() {
 			fmt.Println("Ignored, not a regular file:", source)
 			return nil
@@ -140,7 +167,7 @@ func genMgl64(destPath string) {
 
 		r := strings.NewReplacer("//go:generate ", "//#go:generate ") // We don't want go generate directives in mgl64 package.
 
-		if _, err = r.WriteString(out, string(in)); err != nil {
+		if _, err = r.WriteString(out, stripBuildConstraints(string(in))); err != nil {
 			return err
 		}
 
@@ -152,6 +179,200 @@ func genMgl64(destPath string) {
 	}
 }
 
+// stripBuildConstraints removes the build constraints from src. The only ones
+// in mgl32 select between the assembly and pure Go implementations, and the
+// latter are used unconditionally by mgl64.
+func stripBuildConstraints(src string) string {
+	lines := strings.SplitAfter(src, "\n")
+	var buf bytes.Buffer
+	for _, line := range lines {
+		if strings.HasPrefix(line, "//go:build ") || strings.HasPrefix(line, "// +build ") {
+			continue
+		}
+		buf.WriteString(line)
+	}
+	return buf.String()
+}
+
+// genericFiles are the files of mgl32 rewritten into the generic package.
+var genericFiles = []string{"vector.go", "matrix.go"}
+
+// genGeneric rewrites the generated vector and matrix types of mgl32 into
+// types with an element type parameter T in the package at destPath. Every
+// reference to float32 becomes T, every use of a vector or matrix type, say
+// Vec3, becomes Vec3[T], and package level functions gain the type parameter.
+// Declarations referring to types that have no generic equivalent (like Quat)
+// are dropped.
+func genGeneric(destPath string) {
+	// The types to parameterize, across all files
+	types := map[string]bool{}
+	sources := make([][]byte, len(genericFiles))
+	for i, source := range genericFiles {
+		in, err := ioutil.ReadFile(source)
+		if err != nil {
+			panic(err)
+		}
+		sources[i] = in
+
+		file, err := parser.ParseFile(token.NewFileSet(), source, in, 0)
+		if err != nil {
+			panic(err)
+		}
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.TYPE {
+				for _, spec := range decl.Specs {
+					types[spec.(*ast.TypeSpec).Name.Name] = true
+				}
+			}
+		}
+	}
+
+	for i, source := range genericFiles {
+		dest := filepath.Join(destPath, source)
+		out := fmt.Sprintf("//go:build go1.18\n// +build go1.18\n\n"+
+			"// This file is generated from mgl32/%s; DO NOT EDIT\n\n%s", source, rewriteGeneric(sources[i], types))
+		if err := ioutil.WriteFile(dest, []byte(out), 0644); err != nil {
+			panic(err)
+		}
+
+		if err := rungofmt(dest, false, nil); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// rewriteGeneric rewrites a single source file for genGeneric. It works on
+// the tokens of the file rather than its syntax tree, so that comments and
+// formatting are preserved as they are.
+func rewriteGeneric(src []byte, types map[string]bool) []byte {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		panic(err)
+	}
+	tokFile := fset.File(file.Pos())
+
+	// The source ranges of declarations to drop
+	var dropped [][2]int
+	for _, decl := range file.Decls {
+		if !refersTo(decl, nonGenericTypes) {
+			continue
+		}
+		start := decl.Pos()
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Doc != nil {
+				start = decl.Doc.Pos()
+			}
+		case *ast.GenDecl:
+			if decl.Doc != nil {
+				start = decl.Doc.Pos()
+			}
+		}
+		dropped = append(dropped, [2]int{tokFile.Offset(start), tokFile.Offset(decl.End())})
+	}
+
+	type tok struct {
+		offset int
+		tok    token.Token
+		lit    string
+	}
+	var toks []tok
+	var s scanner.Scanner
+	s.Init(tokFile, src, nil, 0)
+	for {
+		pos, t, lit := s.Scan()
+		if t == token.EOF {
+			break
+		}
+		// Automatically inserted semicolons are not in the source
+		if t == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+		toks = append(toks, tok{tokFile.Offset(pos), t, lit})
+	}
+
+	var buf bytes.Buffer
+	last := 0
+	for i, t := range toks {
+		if len(dropped) > 0 && t.offset >= dropped[0][0] {
+			if t.offset < dropped[0][1] {
+				continue
+			}
+			buf.Write(src[last:dropped[0][0]])
+			last = dropped[0][1]
+			dropped = dropped[1:]
+		}
+		if t.tok != token.IDENT {
+			continue
+		}
+
+		var prev, next token.Token
+		if i > 0 {
+			prev = toks[i-1].tok
+		}
+		if i+1 < len(toks) {
+			next = toks[i+1].tok
+		}
+
+		var replacement string
+		switch {
+		case prev == token.PACKAGE:
+			replacement = "mgl"
+		case t.lit == "float32":
+			replacement = "T"
+		case prev == token.FUNC:
+			// Package level function
+			replacement = t.lit + "[T Float]"
+		case !types[t.lit] || prev == token.PERIOD:
+			continue
+		case prev == token.TYPE:
+			replacement = t.lit + "[T Float]"
+		case prev == token.RPAREN && next == token.LPAREN:
+			// Method with the same name as a type, like Vec3.Vec4
+			continue
+		default:
+			replacement = t.lit + "[T]"
+		}
+
+		buf.Write(src[last:t.offset])
+		buf.WriteString(replacement)
+		last = t.offset + len(t.lit)
+	}
+	// A declaration at the end of the file has no token after it
+	for _, d := range dropped {
+		buf.Write(src[last:d[0]])
+		last = d[1]
+	}
+	buf.Write(src[last:])
+
+	return buf.Bytes()
+}
+
+// nonGenericTypes are the types referred to by the generated vector and matrix
+// code that have no generic equivalent. Declarations referring to them are
+// dropped; this includes the integer vectors, which don't depend on the
+// element type.
+var nonGenericTypes = map[string]bool{
+	"Quat":         true,
+	"RoundingMode": true,
+	"Vec2i":        true,
+	"Vec3i":        true,
+	"Vec4i":        true,
+}
+
+// refersTo returns whether any identifier in node is one of names.
+func refersTo(node ast.Node, names map[string]bool) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && names[id.Name] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
 func rungofmt(path string, fiximports bool, rewriteRules []string) error {
 	args := []string{"-w", path}
 	output, err := exec.Command("gofmt", args...).CombinedOutput()
@@ -272,6 +493,34 @@ func mulHelper(args ...int) int {
 	return res
 }
 
+// Template function that returns all swizzles of a vector with m elements,
+// resulting in vectors of 2 to 4 elements. Elements may be repeated, as in
+// GLSL, so for m = 2 this returns XX, XY, YX, YY, XXX, XXY and so on.
+func swizzleHelper(m int) []Swizzle {
+	var res []Swizzle
+	for size := 2; size <= 4; size++ {
+		indices := make([]int, size)
+		for {
+			name := ""
+			for _, i := range indices {
+				name += elementNameHelper(i)
+			}
+			res = append(res, Swizzle{name, append([]int(nil), indices...)})
+
+			// Next combination, counting in base m
+			k := size - 1
+			for ; k >= 0 && indices[k] == m-1; k-- {
+				indices[k] = 0
+			}
+			if k < 0 {
+				break
+			}
+			indices[k]++
+		}
+	}
+	return res
+}
+
 func (i MatrixIter) String() string {
 	return fmt.Sprintf("%d", i.index)
 }
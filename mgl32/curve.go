@@ -0,0 +1,464 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"sort"
+)
+
+// The functions in this file work on any parametric curve, given as a function
+// of its parameter over the range [t0,t1]. For instance, the Bezier curve with
+// the control points cPoints is
+//
+//	func(t float32) Vec3 { return BezierCurve3D(t, cPoints) }
+//
+// over [0,1]. The curve is never evaluated outside of its range, and where
+// derivatives are needed they are approximated numerically. The functions
+// named FromDerivative take the derivative of the curve instead, which is
+// more accurate where it is known, as for the splines of spline.go.
+
+// ArcLengthTable maps between the parameter of a curve and the distance along
+// it, so the curve can be traversed at constant speed. It is built from a
+// number of samples of the curve, and approximates it as the polyline through
+// them.
+type ArcLengthTable struct {
+	// Params holds the increasing parameters at which the curve was sampled,
+	// and Lengths the arc length from the start of the curve to each of them,
+	// starting at 0.
+	Params  []float32
+	Lengths []float32
+}
+
+// NewArcLengthTable2D samples the curve at numSamples parameters evenly spaced
+// in [t0,t1] and returns their arc length table. More samples give a more
+// accurate table; numSamples must be at least 2 or this function will panic.
+func NewArcLengthTable2D(numSamples int, t0, t1 float32, curve func(float32) Vec2) *ArcLengthTable {
+	table := newArcLengthTable(numSamples, t0, t1)
+	prev := curve(t0)
+	for i := 1; i < numSamples; i++ {
+		p := curve(table.Params[i])
+		table.Lengths[i] = table.Lengths[i-1] + p.Sub(prev).Len()
+		prev = p
+	}
+	return table
+}
+
+// NewArcLengthTable3D is the same as the 2D version, except with the curve in
+// 3D space.
+func NewArcLengthTable3D(numSamples int, t0, t1 float32, curve func(float32) Vec3) *ArcLengthTable {
+	table := newArcLengthTable(numSamples, t0, t1)
+	prev := curve(t0)
+	for i := 1; i < numSamples; i++ {
+		p := curve(table.Params[i])
+		table.Lengths[i] = table.Lengths[i-1] + p.Sub(prev).Len()
+		prev = p
+	}
+	return table
+}
+
+func newArcLengthTable(numSamples int, t0, t1 float32) *ArcLengthTable {
+	if numSamples < 2 {
+		panic("Arc length table needs at least 2 samples")
+	}
+
+	table := &ArcLengthTable{
+		Params:  make([]float32, numSamples),
+		Lengths: make([]float32, numSamples),
+	}
+	for i := range table.Params {
+		table.Params[i] = splineSample(i, numSamples, t0, t1)
+	}
+	return table
+}
+
+// Length returns the total length of the curve.
+func (table *ArcLengthTable) Length() float32 {
+	return table.Lengths[len(table.Lengths)-1]
+}
+
+// ArcLength returns the distance along the curve from its start to the
+// parameter t, which is clamped to the range of the table.
+func (table *ArcLengthTable) ArcLength(t float32) float32 {
+	return tableLookup(t, table.Params, table.Lengths)
+}
+
+// Param returns the parameter of the point at the distance s along the curve
+// from its start. The distance is clamped to the range [0,Length()].
+func (table *ArcLengthTable) Param(s float32) float32 {
+	return tableLookup(s, table.Lengths, table.Params)
+}
+
+// EvenlySpaced returns numPoints parameters whose points are evenly spaced
+// along the curve, including both of its ends.
+func (table *ArcLengthTable) EvenlySpaced(numPoints int) []float32 {
+	params := make([]float32, numPoints)
+	for i := range params {
+		params[i] = table.Param(splineSample(i, numPoints, 0, table.Length()))
+	}
+	if numPoints > 1 {
+		params[numPoints-1] = table.Params[len(table.Params)-1]
+	}
+	return params
+}
+
+// tableLookup linearly interpolates the value at x in a table with the
+// non-decreasing keys xs and the values ys. The key is clamped to the range of
+// the table.
+func tableLookup(x float32, xs, ys []float32) float32 {
+	n := len(xs)
+	if x <= xs[0] {
+		return ys[0]
+	} else if x >= xs[n-1] {
+		return ys[n-1]
+	}
+
+	i := sort.Search(n, func(i int) bool { return xs[i] > x }) - 1
+	if xs[i+1] == xs[i] {
+		return ys[i]
+	}
+	return ys[i] + (ys[i+1]-ys[i])*(x-xs[i])/(xs[i+1]-xs[i])
+}
+
+// MakeArcLengthCurve2D generates numPoints points evenly spaced along the curve
+// by distance rather than by parameter, including both of its ends, using the
+// arc length table of the curve. Moving through the points at a constant rate
+// moves along the curve at a constant speed, unlike the points of
+// MakeBezierCurve2D.
+func MakeArcLengthCurve2D(numPoints int, table *ArcLengthTable, curve func(float32) Vec2) []Vec2 {
+	line := make([]Vec2, numPoints)
+	for i, t := range table.EvenlySpaced(numPoints) {
+		line[i] = curve(t)
+	}
+	return line
+}
+
+// MakeArcLengthCurve3D is the same as the 2D version, except with the curve in
+// 3D space.
+func MakeArcLengthCurve3D(numPoints int, table *ArcLengthTable, curve func(float32) Vec3) []Vec3 {
+	line := make([]Vec3, numPoints)
+	for i, t := range table.EvenlySpaced(numPoints) {
+		line[i] = curve(t)
+	}
+	return line
+}
+
+// curveDerivatives2D approximates the first and second derivatives of the
+// curve at t with second order finite differences.
+func curveDerivatives2D(t, t0, t1 float32, curve func(float32) Vec2) (d1, d2 Vec2) {
+	// The differences are one-sided at the ends of the range, reaching
+	// backwards at t1
+	h := (t1 - t0) * 1e-3
+	if t-h >= t0 && t+h <= t1 {
+		d1 = curve(t + h).Sub(curve(t - h)).Mul(1 / (2 * h))
+	} else {
+		if t+h > t1 {
+			h = -h
+		}
+		d1 = curve(t).Mul(-3).Add(curve(t + h).Mul(4)).Sub(curve(t + 2*h)).Mul(1 / (2 * h))
+	}
+
+	h = (t1 - t0) * 1e-2
+	if t-h >= t0 && t+h <= t1 {
+		d2 = curve(t + h).Sub(curve(t).Mul(2)).Add(curve(t - h)).Mul(1 / (h * h))
+	} else {
+		if t+h > t1 {
+			h = -h
+		}
+		d2 = curve(t).Mul(2).Sub(curve(t + h).Mul(5)).Add(curve(t + 2*h).Mul(4)).Sub(curve(t + 3*h)).Mul(1 / (h * h))
+	}
+	return d1, d2
+}
+
+// curveDerivatives3D is the same as the 2D version, except with the curve in
+// 3D space.
+func curveDerivatives3D(t, t0, t1 float32, curve func(float32) Vec3) (d1, d2 Vec3) {
+	// The differences are one-sided at the ends of the range, reaching
+	// backwards at t1
+	h := (t1 - t0) * 1e-3
+	if t-h >= t0 && t+h <= t1 {
+		d1 = curve(t + h).Sub(curve(t - h)).Mul(1 / (2 * h))
+	} else {
+		if t+h > t1 {
+			h = -h
+		}
+		d1 = curve(t).Mul(-3).Add(curve(t + h).Mul(4)).Sub(curve(t + 2*h)).Mul(1 / (2 * h))
+	}
+
+	h = (t1 - t0) * 1e-2
+	if t-h >= t0 && t+h <= t1 {
+		d2 = curve(t + h).Sub(curve(t).Mul(2)).Add(curve(t - h)).Mul(1 / (h * h))
+	} else {
+		if t+h > t1 {
+			h = -h
+		}
+		d2 = curve(t).Mul(2).Sub(curve(t + h).Mul(5)).Add(curve(t + 2*h).Mul(4)).Sub(curve(t + 3*h)).Mul(1 / (h * h))
+	}
+	return d1, d2
+}
+
+// derivativeDerivatives2D returns the first derivative of a curve at t from
+// the function deriv computing it, and approximates the second derivative with
+// second order finite differences of the first.
+func derivativeDerivatives2D(t, t0, t1 float32, deriv func(float32) Vec2) (d1, d2 Vec2) {
+	d1 = deriv(t)
+	h := (t1 - t0) * 1e-3
+	if t-h >= t0 && t+h <= t1 {
+		d2 = deriv(t + h).Sub(deriv(t - h)).Mul(1 / (2 * h))
+	} else {
+		if t+h > t1 {
+			h = -h
+		}
+		d2 = d1.Mul(-3).Add(deriv(t + h).Mul(4)).Sub(deriv(t + 2*h)).Mul(1 / (2 * h))
+	}
+	return d1, d2
+}
+
+// derivativeDerivatives3D is the same as the 2D version, except with the curve
+// in 3D space.
+func derivativeDerivatives3D(t, t0, t1 float32, deriv func(float32) Vec3) (d1, d2 Vec3) {
+	d1 = deriv(t)
+	h := (t1 - t0) * 1e-3
+	if t-h >= t0 && t+h <= t1 {
+		d2 = deriv(t + h).Sub(deriv(t - h)).Mul(1 / (2 * h))
+	} else {
+		if t+h > t1 {
+			h = -h
+		}
+		d2 = d1.Mul(-3).Add(deriv(t + h).Mul(4)).Sub(deriv(t + 2*h)).Mul(1 / (2 * h))
+	}
+	return d1, d2
+}
+
+// CurveTangent2D returns the unit tangent of the curve at t, pointing in the
+// direction of increasing t. It is the zero vector where the curve stops.
+func CurveTangent2D(t, t0, t1 float32, curve func(float32) Vec2) Vec2 {
+	d1, _ := curveDerivatives2D(t, t0, t1, curve)
+	if l := d1.Len(); l != 0 {
+		return d1.Mul(1 / l)
+	}
+	return Vec2{}
+}
+
+// CurveTangent3D returns the unit tangent of the curve at t, pointing in the
+// direction of increasing t. It is the zero vector where the curve stops.
+func CurveTangent3D(t, t0, t1 float32, curve func(float32) Vec3) Vec3 {
+	d1, _ := curveDerivatives3D(t, t0, t1, curve)
+	if l := d1.Len(); l != 0 {
+		return d1.Mul(1 / l)
+	}
+	return Vec3{}
+}
+
+// CurveNormal2D returns the unit normal of the curve at t, which is its tangent
+// rotated a quarter turn counterclockwise.
+func CurveNormal2D(t, t0, t1 float32, curve func(float32) Vec2) Vec2 {
+	tangent := CurveTangent2D(t, t0, t1, curve)
+	return Vec2{-tangent[1], tangent[0]}
+}
+
+// CurveNormal3D returns the principal unit normal of the curve at t, which
+// points towards the center of its curvature. It is the zero vector where the
+// curve is straight, or too close to straight to tell its normal accurately.
+func CurveNormal3D(t, t0, t1 float32, curve func(float32) Vec3) Vec3 {
+	d1, d2 := curveDerivatives3D(t, t0, t1, curve)
+	return curveNormal3D(d1, d2, t0, t1)
+}
+
+// CurveNormalFromDerivative3D is the same as CurveNormal3D, except that the
+// curve is given by its derivative, as computed by functions like
+// CatmullRomSplineDerivative3D. This is more accurate, see
+// CurveCurvatureFromDerivative2D.
+func CurveNormalFromDerivative3D(t, t0, t1 float32, deriv func(float32) Vec3) Vec3 {
+	d1, d2 := derivativeDerivatives3D(t, t0, t1, deriv)
+	return curveNormal3D(d1, d2, t0, t1)
+}
+
+func curveNormal3D(d1, d2 Vec3, t0, t1 float32) Vec3 {
+	// The curve is taken to be straight where the curvature is too small to
+	// tell apart from the rounding errors of the second derivative, which is
+	// where it bends by less than about half a degree over its whole length.
+	n := d1.Cross(d2).Cross(d1)
+	if l := n.Len(); l*(t1-t0) > d1.LenSqr()*d1.Len()*1e-2 {
+		return n.Mul(1 / l)
+	}
+	return Vec3{}
+}
+
+// CurveCurvature2D returns the signed curvature of the curve at t, which is
+// positive where it turns counterclockwise. Its absolute value is the inverse
+// of the radius of the circle that best fits the curve at t.
+func CurveCurvature2D(t, t0, t1 float32, curve func(float32) Vec2) float32 {
+	return curveCurvature2D(curveDerivatives2D(t, t0, t1, curve))
+}
+
+// CurveCurvatureFromDerivative2D is the same as CurveCurvature2D, except that
+// the curve is given by its derivative, as computed by functions like
+// CatmullRomSplineDerivative2D, over the range [t0,t1]. For instance,
+//
+//	func(t float32) Vec2 { return CatmullRomSplineDerivative2D(t, cPoints) }
+//
+// over [0,1]. The finite differences of the points of a curve lose precision
+// to rounding where the curve is far from the origin compared to its size,
+// while those of its derivative don't.
+func CurveCurvatureFromDerivative2D(t, t0, t1 float32, deriv func(float32) Vec2) float32 {
+	return curveCurvature2D(derivativeDerivatives2D(t, t0, t1, deriv))
+}
+
+func curveCurvature2D(d1, d2 Vec2) float32 {
+	l := d1.Len()
+	if l == 0 {
+		return 0
+	}
+	return (d1[0]*d2[1] - d1[1]*d2[0]) / (l * l * l)
+}
+
+// CurveCurvature3D returns the curvature of the curve at t, the inverse of the
+// radius of the circle that best fits the curve at t.
+func CurveCurvature3D(t, t0, t1 float32, curve func(float32) Vec3) float32 {
+	return curveCurvature3D(curveDerivatives3D(t, t0, t1, curve))
+}
+
+// CurveCurvatureFromDerivative3D is the same as CurveCurvature3D, except that
+// the curve is given by its derivative, which is more accurate, see
+// CurveCurvatureFromDerivative2D.
+func CurveCurvatureFromDerivative3D(t, t0, t1 float32, deriv func(float32) Vec3) float32 {
+	return curveCurvature3D(derivativeDerivatives3D(t, t0, t1, deriv))
+}
+
+func curveCurvature3D(d1, d2 Vec3) float32 {
+	l := d1.Len()
+	if l == 0 {
+		return 0
+	}
+	return d1.Cross(d2).Len() / (l * l * l)
+}
+
+// FrenetFrame3D returns the Frenet frame of the curve at t, a rotation matrix
+// whose columns are the tangent, the principal normal and the binormal. Where
+// the curve is straight the normal is undefined, and an arbitrary one is used.
+//
+// The normal flips at inflection points and spins around the curve where it
+// twists, so RotationMinimizingFrames3D is usually better for orienting
+// objects along a curve.
+func FrenetFrame3D(t, t0, t1 float32, curve func(float32) Vec3) Mat3 {
+	d1, d2 := curveDerivatives3D(t, t0, t1, curve)
+	return frenetFrame3D(d1, d2, t0, t1)
+}
+
+// FrenetFrameFromDerivative3D is the same as FrenetFrame3D, except that the
+// curve is given by its derivative, which is more accurate, see
+// CurveCurvatureFromDerivative2D.
+func FrenetFrameFromDerivative3D(t, t0, t1 float32, deriv func(float32) Vec3) Mat3 {
+	d1, d2 := derivativeDerivatives3D(t, t0, t1, deriv)
+	return frenetFrame3D(d1, d2, t0, t1)
+}
+
+func frenetFrame3D(d1, d2 Vec3, t0, t1 float32) Mat3 {
+	var tangent Vec3
+	if l := d1.Len(); l != 0 {
+		tangent = d1.Mul(1 / l)
+	}
+	normal := curveNormal3D(d1, d2, t0, t1)
+	if normal == (Vec3{}) {
+		normal = perpendicular(tangent)
+	}
+	return Mat3FromCols(tangent, normal, tangent.Cross(normal))
+}
+
+// RotationMinimizingFrames3D returns frames along the curve at each of the
+// given parameters, which should be close enough together to follow the
+// curve, like those returned by ArcLengthTable.EvenlySpaced. Each frame is a
+// rotation matrix whose columns are the tangent, a normal and a binormal, like
+// a Frenet frame. Unlike Frenet frames, the normal rotates as little as
+// possible around the tangent from one frame to the next, which makes these
+// frames suitable for extruding tubes or orienting cameras along the curve.
+// The first normal is the Frenet normal.
+//
+// This uses the double reflection method of Wang, Jüttler, Zheng and Liu.
+func RotationMinimizingFrames3D(params []float32, t0, t1 float32, curve func(float32) Vec3) []Mat3 {
+	tangent := func(t float32) Vec3 { return CurveTangent3D(t, t0, t1, curve) }
+	frenet := func(t float32) Mat3 { return FrenetFrame3D(t, t0, t1, curve) }
+	return rotationMinimizingFrames3D(params, curve, tangent, frenet)
+}
+
+// RotationMinimizingFramesFromDerivative3D is the same as
+// RotationMinimizingFrames3D, except that the tangents come from the
+// derivative deriv of the curve, which is more accurate, see
+// CurveCurvatureFromDerivative2D.
+func RotationMinimizingFramesFromDerivative3D(params []float32, t0, t1 float32, curve, deriv func(float32) Vec3) []Mat3 {
+	tangent := func(t float32) Vec3 {
+		if d1 := deriv(t); d1.Len() != 0 {
+			return d1.Normalize()
+		}
+		return Vec3{}
+	}
+	frenet := func(t float32) Mat3 { return FrenetFrameFromDerivative3D(t, t0, t1, deriv) }
+	return rotationMinimizingFrames3D(params, curve, tangent, frenet)
+}
+
+// rotationMinimizingFrames3D computes the frames with the unit tangents of the
+// curve from curveTangent, starting from the Frenet frame from frenet.
+func rotationMinimizingFrames3D(params []float32, curve, curveTangent func(float32) Vec3, frenet func(float32) Mat3) []Mat3 {
+	frames := make([]Mat3, len(params))
+	if len(params) == 0 {
+		return frames
+	}
+
+	frames[0] = frenet(params[0])
+	p, tangent, normal := curve(params[0]), frames[0].Col(0), frames[0].Col(1)
+	for i := 1; i < len(params); i++ {
+		nextP := curve(params[i])
+		nextTangent := curveTangent(params[i])
+
+		// Reflect the frame in the plane bisecting the two points, then in
+		// the one between the reflected tangent and the next one
+		if v := nextP.Sub(p); v.LenSqr() != 0 {
+			c := 2 / v.LenSqr()
+			normal = normal.Sub(v.Mul(c * v.Dot(normal)))
+			tangent = tangent.Sub(v.Mul(c * v.Dot(tangent)))
+		}
+		if v := nextTangent.Sub(tangent); v.LenSqr() != 0 {
+			normal = normal.Sub(v.Mul(2 / v.LenSqr() * v.Dot(normal)))
+		}
+
+		// Keep the frame orthonormal as rounding errors accumulate
+		p, tangent = nextP, nextTangent
+		if n := normal.Sub(tangent.Mul(normal.Dot(tangent))); n.LenSqr() != 0 {
+			normal = n.Normalize()
+		} else {
+			normal = perpendicular(tangent)
+		}
+		frames[i] = Mat3FromCols(tangent, normal, tangent.Cross(normal))
+	}
+	return frames
+}
+
+// FramesToQuats converts frames such as those of RotationMinimizingFrames3D to
+// the rotations they represent.
+func FramesToQuats(frames []Mat3) []Quat {
+	quats := make([]Quat, len(frames))
+	for i, frame := range frames {
+		quats[i] = Mat4ToQuat(frame.Mat4())
+	}
+	return quats
+}
+
+// perpendicular returns a unit vector perpendicular to v, or the X axis if v
+// is the zero vector.
+func perpendicular(v Vec3) Vec3 {
+	axis := Vec3{1, 0, 0}
+	if Abs(v[0]) > Abs(v[1]) || Abs(v[0]) > Abs(v[2]) {
+		axis = Vec3{0, 1, 0}
+		if Abs(v[1]) > Abs(v[2]) {
+			axis = Vec3{0, 0, 1}
+		}
+	}
+
+	p := v.Cross(axis)
+	if l := p.Len(); l != 0 {
+		return p.Mul(1 / l)
+	}
+	return Vec3{1, 0, 0}
+}
@@ -0,0 +1,204 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+// within returns a function that compares floats with the absolute tolerance
+// tol, for use with the ApproxFuncEqual methods where the expected value may
+// be 0.
+func within(tol float32) func(a, b float32) bool {
+	return func(a, b float32) bool { return Abs(a-b) <= tol }
+}
+
+func circle2D(t float32) Vec2 {
+	s, c := math.Sincos(float64(t))
+	return Vec2{2 * float32(c), 2 * float32(s)}
+}
+
+func helix(t float32) Vec3 {
+	s, c := math.Sincos(float64(t))
+	return Vec3{float32(c), float32(s), t / 2}
+}
+
+func TestArcLengthTable(t *testing.T) {
+	t.Parallel()
+
+	table := NewArcLengthTable2D(512, 0, math.Pi, circle2D)
+	if l := table.Length(); !FloatEqualThreshold(l, 2*math.Pi, 1e-4) {
+		t.Errorf("Length of a half circle = %v, expected %v", l, 2*math.Pi)
+	}
+	if l := table.ArcLength(math.Pi / 2); !FloatEqualThreshold(l, math.Pi, 1e-4) {
+		t.Errorf("ArcLength(pi/2) = %v, expected %v", l, math.Pi)
+	}
+	if p := table.Param(math.Pi); !FloatEqualThreshold(p, math.Pi/2, 1e-4) {
+		t.Errorf("Param(pi) = %v, expected %v", p, math.Pi/2)
+	}
+	if p := table.Param(-1); p != 0 {
+		t.Errorf("Param(-1) = %v, expected 0", p)
+	}
+	if p := table.Param(100); p != math.Pi {
+		t.Errorf("Param(100) = %v, expected pi", p)
+	}
+
+	// A Bezier curve whose speed varies a lot along it
+	cPoints := []Vec3{{0, 0, 0}, {0.1, 0, 0}, {0.2, 0, 0}, {5, 5, 1}}
+	curve := func(t float32) Vec3 { return BezierCurve3D(t, cPoints) }
+	table = NewArcLengthTable3D(1024, 0, 1, curve)
+	line := MakeArcLengthCurve3D(21, table, curve)
+	if !line[0].ApproxEqual(cPoints[0]) || !line[20].ApproxEqual(cPoints[3]) {
+		t.Errorf("MakeArcLengthCurve3D doesn't include the ends, got %v and %v", line[0], line[20])
+	}
+	step := table.Length() / 20
+	for i := 1; i < len(line); i++ {
+		if d := line[i].Sub(line[i-1]).Len(); !FloatEqualThreshold(d, step, 1e-2) {
+			t.Errorf("MakeArcLengthCurve3D step %d has length %v, expected %v", i, d, step)
+		}
+	}
+}
+
+func TestCurveDifferentialGeometry(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float32{0, 1, math.Pi} {
+		if k := CurveCurvature2D(x, 0, math.Pi, circle2D); !FloatEqualThreshold(k, 0.5, 1e-3) {
+			t.Errorf("CurveCurvature2D of a circle of radius 2 = %v, expected 0.5", k)
+		}
+		s, c := math.Sincos(float64(x))
+		tangent := Vec2{-float32(s), float32(c)}
+		if v := CurveTangent2D(x, 0, math.Pi, circle2D); !v.ApproxFuncEqual(tangent, within(1e-3)) {
+			t.Errorf("CurveTangent2D(%v) = %v, expected %v", x, v, tangent)
+		}
+		if v := CurveNormal2D(x, 0, math.Pi, circle2D); !v.ApproxFuncEqual(circle2D(x).Mul(-0.5), within(1e-3)) {
+			t.Errorf("CurveNormal2D(%v) = %v, expected %v", x, v, circle2D(x).Mul(-0.5))
+		}
+	}
+
+	// The helix (cos t, sin t, ct) has the curvature 1/(1+c^2) and its normal
+	// points to the axis
+	for _, x := range []float32{0.5, 2, 5} {
+		if k := CurveCurvature3D(x, 0, 6, helix); !FloatEqualThreshold(k, 0.8, 1e-3) {
+			t.Errorf("CurveCurvature3D of a helix = %v, expected 0.8", k)
+		}
+		p := helix(x)
+		normal := Vec3{-p[0], -p[1], 0}
+		if n := CurveNormal3D(x, 0, 6, helix); !n.ApproxFuncEqual(normal, within(1e-3)) {
+			t.Errorf("CurveNormal3D(%v) = %v, expected %v", x, n, normal)
+		}
+
+		frame := FrenetFrame3D(x, 0, 6, helix)
+		if !frame.Mul3(frame.Transpose()).ApproxFuncEqual(Ident3(), within(1e-4)) || !FloatEqualThreshold(frame.Det(), 1, 1e-4) {
+			t.Errorf("FrenetFrame3D(%v) = %v isn't a rotation", x, frame)
+		}
+	}
+
+	line := func(t float32) Vec3 { return Vec3{t, 2 * t, 0} }
+	if n := CurveNormal3D(0.5, 0, 1, line); n != (Vec3{}) {
+		t.Errorf("CurveNormal3D of a line = %v, expected the zero vector", n)
+	}
+	if k := CurveCurvature3D(0.5, 0, 1, line); !FloatEqualThreshold(k, 0, 1e-2) {
+		t.Errorf("CurveCurvature3D of a line = %v, expected 0", k)
+	}
+}
+
+func TestCurveFromDerivative(t *testing.T) {
+	t.Parallel()
+
+	// A unit circle far from the origin, where finite differences of its
+	// points lose most of their precision
+	center := Vec3{1000, 1000, 1000}
+	circle := func(t float32) Vec3 {
+		s, c := math.Sincos(float64(t))
+		return center.Add(Vec3{float32(c), float32(s), 0})
+	}
+	deriv := func(t float32) Vec3 {
+		s, c := math.Sincos(float64(t))
+		return Vec3{-float32(s), float32(c), 0}
+	}
+	deriv2D := func(t float32) Vec2 { return deriv(t).Vec2() }
+	for _, x := range []float32{0, 0.3, 1, 2, 3, 4, 5, 6, 2 * math.Pi} {
+		if k := CurveCurvatureFromDerivative2D(x, 0, 2*math.Pi, deriv2D); !FloatEqualThreshold(k, 1, 1e-4) {
+			t.Errorf("CurveCurvatureFromDerivative2D(%v) of a unit circle = %v, expected 1", x, k)
+		}
+		if k := CurveCurvatureFromDerivative3D(x, 0, 2*math.Pi, deriv); !FloatEqualThreshold(k, 1, 1e-4) {
+			t.Errorf("CurveCurvatureFromDerivative3D(%v) of a unit circle = %v, expected 1", x, k)
+		}
+		normal := center.Sub(circle(x))
+		normal[2] = 0
+		if n := CurveNormalFromDerivative3D(x, 0, 2*math.Pi, deriv); !n.ApproxFuncEqual(normal, within(1e-4)) {
+			t.Errorf("CurveNormalFromDerivative3D(%v) = %v, expected %v", x, n, normal)
+		}
+		expected := Mat3FromCols(deriv(x), normal, Vec3{0, 0, 1})
+		if frame := FrenetFrameFromDerivative3D(x, 0, 2*math.Pi, deriv); !frame.ApproxFuncEqual(expected, within(1e-4)) {
+			t.Errorf("FrenetFrameFromDerivative3D(%v) = %v, expected %v", x, frame, expected)
+		}
+	}
+
+	// The frames of a plane curve keep the normal of the plane as binormal
+	params := make([]float32, 50)
+	for i := range params {
+		params[i] = splineSample(i, len(params), 0, 2*math.Pi)
+	}
+	for i, frame := range RotationMinimizingFramesFromDerivative3D(params, 0, 2*math.Pi, circle, deriv) {
+		if !frame.Col(2).ApproxFuncEqual(Vec3{0, 0, 1}, within(1e-4)) || !frame.Col(0).ApproxFuncEqual(deriv(params[i]), within(1e-5)) {
+			t.Errorf("RotationMinimizingFramesFromDerivative3D frame %d = %v", i, frame)
+		}
+	}
+
+	// The analytic derivatives of the splines agree with finite differences
+	// near the origin
+	cPoints := []Vec3{{0, 0, 0}, {1, 2, 0}, {3, 1, 1}, {4, 3, -1}, {6, 2, 0}}
+	spline := func(t float32) Vec3 { return CatmullRomSpline3D(t, cPoints) }
+	splineDeriv := func(t float32) Vec3 { return CatmullRomSplineDerivative3D(t, cPoints) }
+	for _, x := range []float32{0.1, 0.3, 0.6, 0.9} {
+		k1, k2 := CurveCurvature3D(x, 0, 1, spline), CurveCurvatureFromDerivative3D(x, 0, 1, splineDeriv)
+		if !FloatEqualThreshold(k1, k2, 1e-2) {
+			t.Errorf("CurveCurvatureFromDerivative3D(%v) of a spline = %v, expected %v", x, k2, k1)
+		}
+	}
+}
+
+func TestRotationMinimizingFrames(t *testing.T) {
+	t.Parallel()
+
+	// For a plane curve, the frames keep the normal of the plane as binormal
+	cPoints := []Vec3{{0, 0, 0}, {1, 2, 0}, {2, -2, 0}, {3, 0, 0}}
+	curve := func(t float32) Vec3 { return BezierCurve3D(t, cPoints) }
+	params := NewArcLengthTable3D(256, 0, 1, curve).EvenlySpaced(64)
+	frames := RotationMinimizingFrames3D(params, 0, 1, curve)
+	binormal := frames[0].Col(2)
+	if !FloatEqualThreshold(Abs(binormal[2]), 1, 1e-4) {
+		t.Errorf("RotationMinimizingFrames3D of a plane curve starts with the binormal %v", binormal)
+	}
+	for i, frame := range frames {
+		if !frame.Col(2).ApproxFuncEqual(binormal, within(1e-3)) {
+			t.Errorf("RotationMinimizingFrames3D frame %d has the binormal %v, expected %v", i, frame.Col(2), binormal)
+		}
+	}
+
+	// Along a helix, the normal rotates only as much as the tangent does
+	params = NewArcLengthTable3D(1024, 0, 12, helix).EvenlySpaced(400)
+	frames = RotationMinimizingFrames3D(params, 0, 12, helix)
+	quats := FramesToQuats(frames)
+	for i := 1; i < len(frames); i++ {
+		tangent, normal := frames[i].Col(0), frames[i].Col(1)
+		if !FloatEqualThreshold(tangent.Dot(normal), 0, 1e-3) || !FloatEqualThreshold(normal.Len(), 1, 1e-4) {
+			t.Errorf("RotationMinimizingFrames3D frame %d isn't orthonormal: %v", i, frames[i])
+		}
+
+		// The change of the normal has no component around the tangent
+		dn := normal.Sub(frames[i-1].Col(1))
+		if twist := dn.Dot(frames[i].Col(2)); Abs(twist) > 1e-3 {
+			t.Errorf("RotationMinimizingFrames3D frame %d twists by %v", i, twist)
+		}
+
+		if v := quats[i].Rotate(Vec3{1, 0, 0}); !v.ApproxFuncEqual(tangent, within(1e-3)) {
+			t.Errorf("FramesToQuats %d rotates X to %v, expected %v", i, v, tangent)
+		}
+	}
+}
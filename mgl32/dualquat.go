@@ -0,0 +1,215 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// DualQuat is a dual quaternion, Real + ε*Dual where ε*ε = 0. Unit dual
+// quaternions represent rigid transformations (a rotation followed by a
+// translation) in the same way that unit quaternions represent rotations: they
+// compose by multiplication, and they can be interpolated and blended without
+// the shearing and volume loss of blending matrices, which makes them popular
+// for skinning.
+//
+// For a rotation q followed by a translation t, Real is q and Dual is
+// Quat{0, t} * q / 2.
+type DualQuat struct {
+	Real, Dual Quat
+}
+
+// DualQuatIdent returns the identity dual quaternion, which represents no
+// rotation and no translation.
+func DualQuatIdent() DualQuat {
+	return DualQuat{QuatIdent(), Quat{}}
+}
+
+// DualQuatFromQuatTranslation creates the dual quaternion that rotates by
+// rotation and then translates by translation. The rotation should be
+// normalized.
+func DualQuatFromQuatTranslation(rotation Quat, translation Vec3) DualQuat {
+	return DualQuat{rotation, Quat{0, translation}.Mul(rotation).Scale(0.5)}
+}
+
+// Mat4ToDualQuat converts a homogeneous matrix made up of only a rotation and
+// a translation into a dual quaternion. Like Mat4ToQuat, the result is
+// undefined if m contains any scaling or shear.
+func Mat4ToDualQuat(m Mat4) DualQuat {
+	return DualQuatFromQuatTranslation(Mat4ToQuat(m).Normalize(), Vec3{m[12], m[13], m[14]})
+}
+
+// Add adds two dual quaternions component-wise.
+func (dq1 DualQuat) Add(dq2 DualQuat) DualQuat {
+	return DualQuat{dq1.Real.Add(dq2.Real), dq1.Dual.Add(dq2.Dual)}
+}
+
+// Scale scales every element of the dual quaternion by c.
+func (dq1 DualQuat) Scale(c float32) DualQuat {
+	return DualQuat{dq1.Real.Scale(c), dq1.Dual.Scale(c)}
+}
+
+// Mul multiplies two dual quaternions. Like Quat.Mul, the result applies dq2
+// first and then dq1.
+func (dq1 DualQuat) Mul(dq2 DualQuat) DualQuat {
+	return DualQuat{
+		dq1.Real.Mul(dq2.Real),
+		dq1.Real.Mul(dq2.Dual).Add(dq1.Dual.Mul(dq2.Real)),
+	}
+}
+
+// Conjugate returns the quaternion conjugate of both parts, Real* + ε*Dual*.
+// For a unit dual quaternion this is the inverse transformation.
+func (dq1 DualQuat) Conjugate() DualQuat {
+	return DualQuat{dq1.Real.Conjugate(), dq1.Dual.Conjugate()}
+}
+
+// DualConjugate returns the dual number conjugate, Real - ε*Dual.
+func (dq1 DualQuat) DualConjugate() DualQuat {
+	return DualQuat{dq1.Real, dq1.Dual.Scale(-1)}
+}
+
+// CombinedConjugate returns both conjugates at once, Real* - ε*Dual*. This is
+// the conjugate used to transform points: p' = dq * (1 + ε*p) * dq.CombinedConjugate().
+func (dq1 DualQuat) CombinedConjugate() DualQuat {
+	return DualQuat{dq1.Real.Conjugate(), dq1.Dual.Conjugate().Scale(-1)}
+}
+
+// Normalize returns the unit dual quaternion closest to dq1: the real part is
+// normalized, and the dual part is scaled the same way and made orthogonal to
+// the real part, which is required for it to represent a rigid transformation.
+// If the real part is zero, this returns the identity.
+func (dq1 DualQuat) Normalize() DualQuat {
+	length := dq1.Real.Len()
+	if length == 0 {
+		return DualQuatIdent()
+	}
+
+	r := dq1.Real.Scale(1 / length)
+	d := dq1.Dual.Scale(1 / length)
+	d = d.Sub(r.Scale(r.Dot(d)))
+
+	return DualQuat{r, d}
+}
+
+// Rotation returns the rotation part of a unit dual quaternion.
+func (dq1 DualQuat) Rotation() Quat {
+	return dq1.Real
+}
+
+// Translation returns the translation part of a unit dual quaternion, which is
+// applied after the rotation.
+func (dq1 DualQuat) Translation() Vec3 {
+	return dq1.Dual.Scale(2).Mul(dq1.Real.Conjugate()).V
+}
+
+// TransformPoint applies the rigid transformation represented by the unit dual
+// quaternion to a point: it is rotated and then translated.
+func (dq1 DualQuat) TransformPoint(p Vec3) Vec3 {
+	return dq1.Real.Rotate(p).Add(dq1.Translation())
+}
+
+// TransformDirection applies only the rotation represented by the unit dual
+// quaternion to a vector, such as a direction or a normal.
+func (dq1 DualQuat) TransformDirection(v Vec3) Vec3 {
+	return dq1.Real.Rotate(v)
+}
+
+// Mat4 returns the homogeneous 3D matrix equivalent to the unit dual
+// quaternion.
+func (dq1 DualQuat) Mat4() Mat4 {
+	m := dq1.Real.Mat4()
+	t := dq1.Translation()
+	m[12], m[13], m[14] = t[0], t[1], t[2]
+
+	return m
+}
+
+// Pow raises a unit dual quaternion to the power t. This scales the screw
+// motion it represents: the rotation about the screw axis and the translation
+// along it are both multiplied by t.
+//
+// Like Quat.Pow, dq1 and dq1.Scale(-1) represent the same transformation, but
+// do not give the same result; the one with a non-negative Real.W takes the
+// shorter path.
+func (dq1 DualQuat) Pow(t float32) DualQuat {
+	vLen := dq1.Real.V.Len()
+	if vLen < 1e-6 {
+		// No rotation, so this is a pure translation which simply scales
+		if dq1.Real.W < 0 {
+			dq1 = dq1.Scale(-1)
+		}
+		return DualQuat{QuatIdent(), Quat{0, dq1.Dual.V.Mul(t)}}
+	}
+
+	// Screw parameters: angle about and distance along the axis l, and the
+	// moment m of the axis around the origin
+	angle := 2 * float32(math.Atan2(float64(vLen), float64(dq1.Real.W)))
+	l := dq1.Real.V.Mul(1 / vLen)
+	dist := -2 * dq1.Dual.W / vLen
+	m := dq1.Dual.V.Sub(l.Mul(dist * 0.5 * dq1.Real.W)).Mul(1 / vLen)
+
+	angle *= t
+	dist *= t
+	sn, cs := math.Sincos(float64(angle / 2))
+	s, c := float32(sn), float32(cs)
+
+	return DualQuat{
+		Quat{c, l.Mul(s)},
+		Quat{-dist / 2 * s, m.Mul(s).Add(l.Mul(dist / 2 * c))},
+	}
+}
+
+// DualQuatScLerp is *Sc*rew *L*inear Int*erp*olation between two unit dual
+// quaternions, the rigid transformation equivalent of QuatSlerp. The
+// interpolated transformations follow a single screw motion, rotating about
+// and moving along a fixed axis at constant speed, and take the shortest path.
+func DualQuatScLerp(dq1, dq2 DualQuat, amount float32) DualQuat {
+	if dq1.Real.Dot(dq2.Real) < 0 {
+		dq2 = dq2.Scale(-1)
+	}
+
+	return dq1.Mul(dq1.Conjugate().Mul(dq2).Pow(amount))
+}
+
+// DualQuatBlend performs dual quaternion linear blending (DLB) of the unit
+// dual quaternions dqs with the corresponding weights. This is the weighted
+// sum of the transformations, normalized, which unlike blending matrices
+// always results in a rigid transformation, avoiding the "candy wrapper"
+// artifacts of linear blend skinning.
+//
+// Transformations in the other hemisphere than dqs[0] are negated before
+// blending so the shortest path is taken. The weights usually add up to 1, but
+// don't need to. If weights is shorter than dqs, this panics. If dqs is empty,
+// this returns the identity.
+func DualQuatBlend(dqs []DualQuat, weights []float32) DualQuat {
+	if len(dqs) == 0 {
+		return DualQuatIdent()
+	}
+
+	var sum DualQuat
+	for i, dq := range dqs {
+		w := weights[i]
+		if dq.Real.Dot(dqs[0].Real) < 0 {
+			w = -w
+		}
+		sum = sum.Add(dq.Scale(w))
+	}
+
+	return sum.Normalize()
+}
+
+// ApproxEqual returns whether the dual quaternions are approximately equal, as
+// if FloatEqual was called on each matching element.
+func (dq1 DualQuat) ApproxEqual(dq2 DualQuat) bool {
+	return dq1.Real.ApproxEqual(dq2.Real) && dq1.Dual.ApproxEqual(dq2.Dual)
+}
+
+// ApproxEqualThreshold returns whether the dual quaternions are approximately
+// equal with a given tolerance, as if FloatEqualThreshold was called on each
+// matching element with the given epsilon.
+func (dq1 DualQuat) ApproxEqualThreshold(dq2 DualQuat, epsilon float32) bool {
+	return dq1.Real.ApproxEqualThreshold(dq2.Real, epsilon) && dq1.Dual.ApproxEqualThreshold(dq2.Dual, epsilon)
+}
@@ -0,0 +1,128 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestDualQuatTransform(t *testing.T) {
+	t.Parallel()
+
+	rot := QuatRotate(1.1, Vec3{1, 2, -1}.Normalize())
+	trans := Vec3{3, -4, 5}
+	dq := DualQuatFromQuatTranslation(rot, trans)
+	m := Translate3D(3, -4, 5).Mul4(rot.Mat4())
+
+	if !dq.Mat4().ApproxEqualThreshold(m, 1e-5) {
+		t.Errorf("DualQuat.Mat4() = %v, expected %v", dq.Mat4(), m)
+	}
+	if r := Mat4ToDualQuat(m); !r.ApproxEqualThreshold(dq, 1e-5) && !r.ApproxEqualThreshold(dq.Scale(-1), 1e-5) {
+		t.Errorf("Mat4ToDualQuat(%v) = %v, expected %v", m, r, dq)
+	}
+	if r := dq.Translation(); !r.ApproxEqualThreshold(trans, 1e-5) {
+		t.Errorf("Translation = %v, expected %v", r, trans)
+	}
+
+	for _, p := range []Vec3{{0, 0, 0}, {1, 0, 0}, {-2, 3, 0.5}} {
+		if r, e := dq.TransformPoint(p), TransformCoordinate(p, m); !r.ApproxEqualThreshold(e, 1e-5) {
+			t.Errorf("TransformPoint(%v) = %v, expected %v", p, r, e)
+		}
+		if r, e := dq.TransformDirection(p), TransformNormal(p, m); !r.ApproxEqualThreshold(e, 1e-5) {
+			t.Errorf("TransformDirection(%v) = %v, expected %v", p, r, e)
+		}
+
+		// The sandwich product with the combined conjugate
+		pq := DualQuat{QuatIdent(), Quat{0, p}}
+		if r := dq.Mul(pq).Mul(dq.CombinedConjugate()).Dual.V; !r.ApproxEqualThreshold(TransformCoordinate(p, m), 1e-5) {
+			t.Errorf("dq * p * dq.CombinedConjugate() = %v, expected %v", r, TransformCoordinate(p, m))
+		}
+	}
+}
+
+func TestDualQuatMul(t *testing.T) {
+	t.Parallel()
+
+	dq1 := DualQuatFromQuatTranslation(QuatRotate(0.5, Vec3{0, 1, 0}), Vec3{1, 2, 3})
+	dq2 := DualQuatFromQuatTranslation(QuatRotate(-1, Vec3{1, 0, 0}), Vec3{0, -1, 2})
+
+	// Some of the elements are 0, where a relative comparison is too strict
+	// for the rounding errors of fused multiply-adds
+	if r, e := dq1.Mul(dq2).Mat4(), dq1.Mat4().Mul4(dq2.Mat4()); !r.ApproxFuncEqual(e, within(1e-5)) {
+		t.Errorf("Product of dual quaternions is %v, expected %v", r, e)
+	}
+	if r := dq1.Mul(dq1.Conjugate()); !r.ApproxEqualThreshold(DualQuatIdent(), 1e-6) {
+		t.Errorf("Product of dual quaternion and its conjugate is %v, expected identity", r)
+	}
+	if r := dq1.DualConjugate(); r.Real != dq1.Real || r.Dual != dq1.Dual.Scale(-1) {
+		t.Errorf("DualConjugate of %v is %v", dq1, r)
+	}
+
+	scaled := DualQuat{dq1.Real.Scale(3), dq1.Dual.Scale(3).Add(dq1.Real.Scale(0.1))}
+	if r := scaled.Normalize(); !r.ApproxEqualThreshold(dq1, 1e-5) {
+		t.Errorf("Normalize of %v is %v, expected %v", scaled, r, dq1)
+	}
+}
+
+func TestDualQuatScLerp(t *testing.T) {
+	t.Parallel()
+
+	axis := Vec3{0, 0, 1}
+	dq1 := DualQuatFromQuatTranslation(QuatIdent(), Vec3{})
+	dq2 := DualQuatFromQuatTranslation(QuatRotate(1, axis), Vec3{0, 0, 4})
+
+	// A screw about the Z axis through the origin
+	tests := []float32{0, 0.25, 0.5, 1}
+	for _, a := range tests {
+		e := DualQuatFromQuatTranslation(QuatRotate(a, axis), Vec3{0, 0, 4 * a})
+		if r := DualQuatScLerp(dq1, dq2, a); !r.ApproxEqualThreshold(e, 1e-5) {
+			t.Errorf("DualQuatScLerp(%v, %v, %v) = %v, expected %v", dq1, dq2, a, r, e)
+		}
+	}
+
+	// Endpoints, and the shortest path for negated inputs
+	dq3 := DualQuatFromQuatTranslation(QuatRotate(2, Vec3{1, 1, 0}.Normalize()), Vec3{1, -2, 3})
+	if r := DualQuatScLerp(dq2, dq3, 1); !r.Mat4().ApproxEqualThreshold(dq3.Mat4(), 1e-5) {
+		t.Errorf("DualQuatScLerp at 1 is %v, expected %v", r, dq3)
+	}
+	r1, r2 := DualQuatScLerp(dq2, dq3, 0.3), DualQuatScLerp(dq2, dq3.Scale(-1), 0.3)
+	if !r1.Mat4().ApproxEqualThreshold(r2.Mat4(), 1e-5) {
+		t.Errorf("DualQuatScLerp with negated input gives %v, expected %v", r2, r1)
+	}
+
+	// Pure translation
+	dq4 := DualQuatFromQuatTranslation(QuatIdent(), Vec3{2, 0, 0})
+	if r := DualQuatScLerp(dq1, dq4, 0.5).Translation(); !r.ApproxEqual(Vec3{1, 0, 0}) {
+		t.Errorf("DualQuatScLerp of translation is %v, expected %v", r, Vec3{1, 0, 0})
+	}
+}
+
+func TestDualQuatBlend(t *testing.T) {
+	t.Parallel()
+
+	dq1 := DualQuatFromQuatTranslation(QuatRotate(0.4, Vec3{0, 1, 0}), Vec3{1, 0, 0})
+	dq2 := DualQuatFromQuatTranslation(QuatRotate(-0.8, Vec3{0, 1, 0}), Vec3{0, 2, 0})
+
+	if r := DualQuatBlend([]DualQuat{dq1}, []float32{1}); !r.ApproxEqualThreshold(dq1, 1e-6) {
+		t.Errorf("Blend of single transformation is %v, expected %v", r, dq1)
+	}
+
+	// The result is rigid, and the negated copy of dq2 does not change it
+	r := DualQuatBlend([]DualQuat{dq1, dq2}, []float32{0.5, 0.5})
+	r2 := DualQuatBlend([]DualQuat{dq1, dq2.Scale(-1)}, []float32{0.5, 0.5})
+	if !r.ApproxEqualThreshold(r2, 1e-6) {
+		t.Errorf("Blend with negated input is %v, expected %v", r2, r)
+	}
+	if l := r.Real.Len(); !FloatEqualThreshold(l, 1, 1e-6) || Abs(r.Real.Dot(r.Dual)) > 1e-6 {
+		t.Errorf("Blend %v is not a unit dual quaternion", r)
+	}
+	if rot := r.Rotation(); !rot.OrientationEqualThreshold(QuatRotate(-0.2, Vec3{0, 1, 0}), 1e-5) {
+		t.Errorf("Blend rotation is %v, expected %v", rot, QuatRotate(-0.2, Vec3{0, 1, 0}))
+	}
+
+	if r := DualQuatBlend(nil, nil); r != DualQuatIdent() {
+		t.Errorf("Blend of nothing is %v, expected identity", r)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// JacobiOptions controls the iteration of the Jacobi methods used by
+// SymEigen and SVD. The zero value selects the defaults for every field.
+type JacobiOptions struct {
+	// Tolerance is the relative accuracy at which the iteration stops. For
+	// SymEigen, this is the size of the off-diagonal part of the matrix relative
+	// to the whole (both measured by the Frobenius norm). For SVD it's the
+	// cosine of the angle between any two columns being orthogonalized. The
+	// default is the machine precision of float32.
+	Tolerance float32
+
+	// MaxIterations is the maximum number of sweeps, each of which applies one
+	// rotation to every pair of rows and columns. The default is 50, which is
+	// plenty for the quadratic convergence of the Jacobi method.
+	MaxIterations int
+}
+
+func (opts JacobiOptions) tolerance() float32 {
+	if opts.Tolerance <= 0 {
+		return machineEpsilon
+	}
+	return opts.Tolerance
+}
+
+func (opts JacobiOptions) maxIterations() int {
+	if opts.MaxIterations <= 0 {
+		return 50
+	}
+	return opts.MaxIterations
+}
+
+// SymEigen computes the eigenvalues and eigenvectors of the symmetric matrix m
+// with the cyclic Jacobi method. The eigenvalues are sorted in descending
+// order, and column i of vectors is the unit eigenvector belonging to
+// values[i]. The eigenvectors form a rotation matrix (with a determinant of
+// 1), so for instance the principal axes of a covariance matrix or inertia
+// tensor can be used directly as the orientation of a body.
+//
+// If m is not symmetric, the result is undefined. If the iteration does not
+// converge within opts.MaxIterations sweeps, ok is false, but the result is
+// still the best approximation found.
+func (m Mat3) SymEigen(opts JacobiOptions) (values Vec3, vectors Mat3, ok bool) {
+	ok = jacobiEigen(m[:], vectors[:], 3, opts)
+	for i := range values {
+		values[i] = m.At(i, i)
+	}
+	sortEigen(values[:], vectors[:], 3)
+
+	if vectors.Det() < 0 {
+		vectors.SetCol(2, vectors.Col(2).Mul(-1))
+	}
+
+	return values, vectors, ok
+}
+
+// SymEigen computes the eigenvalues and eigenvectors of the symmetric matrix
+// mat with the cyclic Jacobi method. The eigenvalues are stored in values and
+// the eigenvectors as the columns of vectors, which are Resized and Reshaped
+// as necessary, and both are returned. The eigenvalues are sorted in descending
+// order, and column i of vectors is the unit eigenvector belonging to the i-th
+// eigenvalue.
+//
+// If mat is not symmetric, the result is undefined. If mat is nil or not
+// square, a NilMatrixError or RectangularMatrixError is returned, and if the
+// iteration does not converge within opts.MaxIterations sweeps a
+// NoConvergenceError. In the latter case, values and vectors hold the best
+// approximation found.
+func (mat *MatMxN) SymEigen(values *VecN, vectors *MatMxN, opts JacobiOptions) (*VecN, *MatMxN, error) {
+	if mat == nil {
+		return nil, nil, NilMatrixError{}
+	}
+	if mat.m != mat.n {
+		return nil, nil, RectangularMatrixError{}
+	}
+
+	n := mat.m
+	a := NewMatrix(n, n)
+	defer a.destroy()
+	CopyMatMN(a, mat)
+
+	vectors = vectors.Reshape(n, n)
+	ok := jacobiEigen(a.dat, vectors.dat, n, opts)
+
+	values = values.Resize(n)
+	for i := range values.vec {
+		values.vec[i] = a.At(i, i)
+	}
+	sortEigen(values.vec, vectors.dat, n)
+
+	if !ok {
+		return values, vectors, NoConvergenceError{}
+	}
+	return values, vectors, nil
+}
+
+// jacobiEigen diagonalizes the symmetric nxn matrix a in place with Jacobi
+// rotations, accumulating the rotations in v, which is overwritten. Both
+// matrices are stored in column-major order. It returns whether the iteration
+// converged.
+func jacobiEigen(a, v []float32, n int, opts JacobiOptions) bool {
+	tol, maxIter := opts.tolerance(), opts.maxIterations()
+
+	for i := range v[:n*n] {
+		v[i] = 0
+	}
+	var norm float32
+	for i := 0; i < n; i++ {
+		v[i*n+i] = 1
+		for j := 0; j < n; j++ {
+			norm += a[j*n+i] * a[j*n+i]
+		}
+	}
+
+	for sweep := 0; ; sweep++ {
+		var off float32
+		for q := 1; q < n; q++ {
+			for p := 0; p < q; p++ {
+				off += 2 * a[q*n+p] * a[q*n+p]
+			}
+		}
+		if off <= tol*tol*norm {
+			return true
+		}
+		if sweep == maxIter {
+			return false
+		}
+
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				apq := a[q*n+p]
+				if apq == 0 {
+					continue
+				}
+				app, aqq := a[p*n+p], a[q*n+q]
+
+				// After a few sweeps, elements too small to change the diagonal
+				// are dropped instead of rotated away
+				g := 100 * Abs(apq)
+				if sweep > 3 && Abs(app)+g == Abs(app) && Abs(aqq)+g == Abs(aqq) {
+					a[q*n+p], a[p*n+q] = 0, 0
+					continue
+				}
+
+				// The rotation angle that zeroes a[p][q], taking the smaller
+				// root for stability
+				theta := (aqq - app) / (2 * apq)
+				t := 1 / (Abs(theta) + float32(math.Sqrt(float64(theta*theta+1))))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / float32(math.Sqrt(float64(t*t+1)))
+				s := t * c
+
+				a[p*n+p] = app - t*apq
+				a[q*n+q] = aqq + t*apq
+				a[q*n+p], a[p*n+q] = 0, 0
+
+				for r := 0; r < n; r++ {
+					if r != p && r != q {
+						arp, arq := a[p*n+r], a[q*n+r]
+						a[p*n+r] = c*arp - s*arq
+						a[r*n+p] = a[p*n+r]
+						a[q*n+r] = s*arp + c*arq
+						a[r*n+q] = a[q*n+r]
+					}
+
+					vrp, vrq := v[p*n+r], v[q*n+r]
+					v[p*n+r] = c*vrp - s*vrq
+					v[q*n+r] = s*vrp + c*vrq
+				}
+			}
+		}
+	}
+}
+
+// sortEigen sorts values in descending order, permuting the columns of the
+// column-major nxn matrix vectors along with them.
+func sortEigen(values, vectors []float32, n int) {
+	for i := 0; i < n-1; i++ {
+		max := i
+		for j := i + 1; j < n; j++ {
+			if values[j] > values[max] {
+				max = j
+			}
+		}
+		if max == i {
+			continue
+		}
+
+		values[i], values[max] = values[max], values[i]
+		for r := 0; r < n; r++ {
+			vectors[i*n+r], vectors[max*n+r] = vectors[max*n+r], vectors[i*n+r]
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMat3SymEigen(t *testing.T) {
+	t.Parallel()
+
+	// A diagonal matrix in a rotated frame has a known decomposition
+	rot := QuatRotate(0.7, Vec3{1, 2, 3}.Normalize()).Mat4().Mat3()
+	m := rot.Mul3(Diag3(Vec3{1, 5, 3})).Mul3(rot.Transpose())
+
+	values, vectors, ok := m.SymEigen(JacobiOptions{})
+	if !ok {
+		t.Fatalf("SymEigen did not converge")
+	}
+	if !values.ApproxEqualThreshold(Vec3{5, 3, 1}, 1e-5) {
+		t.Errorf("Eigenvalues are %v, expected %v", values, Vec3{5, 3, 1})
+	}
+	if det := vectors.Det(); !FloatEqualThreshold(det, 1, 1e-5) {
+		t.Errorf("Eigenvectors have determinant %v, expected 1", det)
+	}
+
+	for i := 0; i < 3; i++ {
+		v := vectors.Col(i)
+		if mv := m.Mul3x1(v); !mv.ApproxEqualThreshold(v.Mul(values[i]), 1e-5) {
+			t.Errorf("Eigenvector %v does not satisfy m*v = %v*v: %v", v, values[i], mv)
+		}
+	}
+
+	// Repeated eigenvalues
+	values, vectors, ok = Ident3().Mul(2).SymEigen(JacobiOptions{})
+	if !ok || values != (Vec3{2, 2, 2}) || vectors != Ident3() {
+		t.Errorf("SymEigen of 2I = %v, %v, %v; expected %v, identity, true", values, vectors, ok, Vec3{2, 2, 2})
+	}
+}
+
+func TestMxNSymEigen(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(2))
+
+	// B^T * B is symmetric
+	b := NewMatrix(6, 5)
+	for i := range b.dat {
+		b.dat[i] = r.Float32()*2 - 1
+	}
+	m := b.Transpose(nil).MulMxN(nil, b)
+
+	values, vectors, err := m.SymEigen(nil, nil, JacobiOptions{})
+	if err != nil {
+		t.Fatalf("SymEigen returned error: %v", err)
+	}
+
+	near := func(a, b float32) bool { return Abs(a-b) < 1e-4 }
+	if prod := vectors.Transpose(nil).MulMxN(nil, vectors); !prod.ApproxEqualFunc(IdentN(nil, 5), near) {
+		t.Errorf("Eigenvectors are not orthonormal: V^T * V = %v", prod)
+	}
+
+	reconstructed := vectors.MulMxN(nil, DiagN(nil, values)).MulMxN(nil, vectors.Transpose(nil))
+	if !reconstructed.ApproxEqualFunc(m, near) {
+		t.Errorf("V * D * V^T is %v, expected %v", reconstructed, m)
+	}
+
+	for i := 1; i < values.Size(); i++ {
+		if values.Get(i) > values.Get(i-1) {
+			t.Errorf("Eigenvalues are not sorted: %v", values.Raw())
+		}
+	}
+
+	// Capping the number of sweeps prevents convergence
+	if _, _, err := m.SymEigen(nil, nil, JacobiOptions{MaxIterations: 1}); err != (NoConvergenceError{}) {
+		t.Errorf("SymEigen with one sweep returned %v, expected NoConvergenceError", err)
+	}
+
+	if _, _, err := NewMatrix(2, 3).SymEigen(nil, nil, JacobiOptions{}); err != (RectangularMatrixError{}) {
+		t.Errorf("SymEigen of rectangular matrix returned %v, expected RectangularMatrixError", err)
+	}
+}
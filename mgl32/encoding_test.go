@@ -0,0 +1,189 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type encodable interface {
+	encoding.TextMarshaler
+	json.Marshaler
+	encoding.BinaryMarshaler
+}
+
+type decodable interface {
+	encoding.TextUnmarshaler
+	json.Unmarshaler
+	encoding.BinaryUnmarshaler
+}
+
+func TestEncodingRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In  encodable
+		Out func() decodable
+	}{
+		{Vec2{1, -2.5}, func() decodable { return new(Vec2) }},
+		{Vec3{1, 2, 3e-20}, func() decodable { return new(Vec3) }},
+		{Vec4{1, 2, 3, 4}, func() decodable { return new(Vec4) }},
+		{Mat2{1, 2, 3, 4}, func() decodable { return new(Mat2) }},
+		{Mat2x3{1, 2, 3, 4, 5, 6}, func() decodable { return new(Mat2x3) }},
+		{Mat3x4{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, func() decodable { return new(Mat3x4) }},
+		{HomogRotate3DX(0.3), func() decodable { return new(Mat4) }},
+		{QuatRotate(0.5, Vec3{0, 1, 0}), func() decodable { return new(Quat) }},
+		{*NewVecNFromData([]float32{1, 2, 3, 4, 5}), func() decodable { return new(VecN) }},
+		{NewMatrixFromData([]float32{1, 2, 3, 4, 5, 6}, 2, 3), func() decodable { return new(MatMxN) }},
+	}
+
+	for _, test := range tests {
+		text, err := test.In.MarshalText()
+		if err != nil {
+			t.Fatalf("%T.MarshalText: %v", test.In, err)
+		}
+		js, err := test.In.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%T.MarshalJSON: %v", test.In, err)
+		}
+		bin, err := test.In.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%T.MarshalBinary: %v", test.In, err)
+		}
+
+		decoders := []struct {
+			Name   string
+			Decode func(decodable) error
+		}{
+			{"UnmarshalText", func(d decodable) error { return d.UnmarshalText(text) }},
+			{"UnmarshalJSON", func(d decodable) error { return d.UnmarshalJSON(js) }},
+			{"UnmarshalBinary", func(d decodable) error { return d.UnmarshalBinary(bin) }},
+		}
+		for _, dec := range decoders {
+			out := test.Out()
+			if err := dec.Decode(out); err != nil {
+				t.Errorf("%T.%s: %v", test.In, dec.Name, err)
+				continue
+			}
+			got := reflect.ValueOf(out).Elem().Interface()
+			var want interface{} = test.In
+			if m, ok := want.(*MatMxN); ok {
+				want = *m
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%T.%s round trip got %v, expected %v", test.In, dec.Name, got, want)
+			}
+		}
+	}
+}
+
+func TestEncodingFormat(t *testing.T) {
+	t.Parallel()
+
+	m := Mat2x3{1, 2, 3, 4, 5, 6}
+	if text, _ := m.MarshalText(); string(text) != "1 2 3 4 5 6" {
+		t.Errorf("Mat2x3.MarshalText got %q", text)
+	}
+
+	q := Quat{1, Vec3{2, 3, 4}}
+	if js, _ := json.Marshal(q); string(js) != "[1,2,3,4]" {
+		t.Errorf("json.Marshal(Quat) got %s", js)
+	}
+
+	mn := NewMatrixFromData(m[:], 2, 3)
+	if text, _ := mn.MarshalText(); string(text) != "2x3 1 2 3 4 5 6" {
+		t.Errorf("MatMxN.MarshalText got %q", text)
+	}
+	if js, _ := mn.MarshalJSON(); string(js) != `{"rows":2,"cols":3,"data":[1,2,3,4,5,6]}` {
+		t.Errorf("MatMxN.MarshalJSON got %s", js)
+	}
+
+	bin, _ := m.MarshalBinary()
+	var decoded Mat2x3
+	if err := binary.Read(bytes.NewReader(bin), binary.LittleEndian, &decoded); err != nil || decoded != m {
+		t.Errorf("Mat2x3.MarshalBinary got %x, which decodes to %v (%v)", bin, decoded, err)
+	}
+}
+
+func TestEncodingJSONStruct(t *testing.T) {
+	t.Parallel()
+
+	type object struct {
+		Position Vec3
+		Rotation Quat
+		Model    Mat4
+		Weights  VecN
+	}
+
+	in := object{
+		Position: Vec3{1, 2, 3},
+		Rotation: QuatIdent(),
+		Model:    Translate3D(1, 2, 3),
+		Weights:  *NewVecNFromData([]float32{0.5, 0.25}),
+	}
+	js, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out object
+	if err := json.Unmarshal(js, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("JSON round trip got %v, expected %v", out, in)
+	}
+
+	// The encoding used before Quat implemented json.Marshaler
+	if err := json.Unmarshal([]byte(`{"W":1,"V":[2,3,4]}`), &out.Rotation); err != nil {
+		t.Fatal(err)
+	}
+	if expected := (Quat{1, Vec3{2, 3, 4}}); out.Rotation != expected {
+		t.Errorf("Quat.UnmarshalJSON got %v, expected %v", out.Rotation, expected)
+	}
+}
+
+func TestEncodingErrors(t *testing.T) {
+	t.Parallel()
+
+	var v Vec3
+	var q Quat
+	var mn MatMxN
+	tests := []struct {
+		Name string
+		Err  error
+	}{
+		{"too few text elements", v.UnmarshalText([]byte("1 2"))},
+		{"bad text element", v.UnmarshalText([]byte("1 two 3"))},
+		{"too many JSON elements", v.UnmarshalJSON([]byte("[1,2,3,4]"))},
+		{"JSON object", v.UnmarshalJSON([]byte(`{"x":1}`))},
+		{"short binary", v.UnmarshalBinary(make([]byte, 11))},
+		{"Quat text", q.UnmarshalText([]byte("1 2 3"))},
+		{"MatMxN size", mn.UnmarshalText([]byte("2y3 1 2 3 4 5 6"))},
+		{"MatMxN elements", mn.UnmarshalText([]byte("2x3 1 2 3 4 5"))},
+		{"MatMxN JSON data", mn.UnmarshalJSON([]byte(`{"rows":2,"cols":2,"data":[1,2,3]}`))},
+		{"MatMxN binary", mn.UnmarshalBinary([]byte{2, 0, 0, 0, 2, 0, 0, 0})},
+		// Sizes whose product overflows, which must not be multiplied out
+		{"MatMxN overflowing size", mn.UnmarshalText([]byte("4294967296x4294967296"))},
+		{"MatMxN overflowing JSON size", mn.UnmarshalJSON([]byte(`{"rows":4294967296,"cols":4294967296,"data":[]}`))},
+		{"MatMxN overflowing binary size", mn.UnmarshalBinary([]byte{0, 0, 0, 128, 0, 0, 0, 128})},
+		{"MatMxN empty with elements", mn.UnmarshalText([]byte("0x3 1 2 3"))},
+		{"MatMxN partial binary element", mn.UnmarshalBinary([]byte{1, 0, 0, 0, 1, 0, 0, 0, 0, 0})},
+	}
+	for _, test := range tests {
+		if test.Err == nil {
+			t.Errorf("%s: expected an error", test.Name)
+		}
+	}
+
+	if _, err := (Vec2{NaN, 0}).MarshalJSON(); err == nil {
+		t.Errorf("MarshalJSON of NaN: expected an error")
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Containment is the result of classifying a point or volume against the
+// planes of a view frustum.
+type Containment int
+
+// The Containment constants describe where a point or volume lies relative
+// to a view frustum.
+const (
+	// Outside means no part of the volume is inside the frustum.
+	Outside Containment = iota
+	// Inside means the volume is entirely inside the frustum.
+	Inside
+	// Intersecting means the volume straddles the boundary of the frustum,
+	// or, for a point, that the point lies exactly on the boundary.
+	Intersecting
+)
+
+// Indices of the planes within FrustumPlanes.
+const (
+	FrustumLeft = iota
+	FrustumRight
+	FrustumBottom
+	FrustumTop
+	FrustumNear
+	FrustumFar
+)
+
+// FrustumPlanes describes a convex volume bounded by six planes, typically the
+// view frustum visible to a camera. The planes are stored in the order given
+// by the FrustumLeft...FrustumFar constants, are normalized, and have their
+// normals pointing into the frustum, so a point is inside if its
+// SignedDistance to every plane is positive.
+//
+// (This is not called Frustum because that name is taken by the function
+// building a perspective matrix.)
+type FrustumPlanes [6]Plane
+
+// ExtractFrustumPlanes extracts the six clipping planes of a (projection)
+// matrix using the method of Gribb and Hartmann ("Fast Extraction of Viewing
+// Frustum Planes from the World-View-Projection Matrix", 2001). The matrix is assumed
+// to produce OpenGL clip coordinates, that is, a point is visible if after the
+// transformation -w <= x, y, z <= w.
+//
+// The space in which the planes are expressed depends on the matrix. Passing
+// only the projection matrix (e.g. from Perspective or Frustum) yields planes
+// in eye space; projection.Mul4(view) yields them in world space and
+// projection.Mul4(view).Mul4(model) in object space.
+func ExtractFrustumPlanes(m Mat4) FrustumPlanes {
+	row0, row1, row2, row3 := m.Rows()
+
+	var f FrustumPlanes
+	f[FrustumLeft] = Plane{row3.Add(row0).Vec3(), row3[3] + row0[3]}.Normalize()
+	f[FrustumRight] = Plane{row3.Sub(row0).Vec3(), row3[3] - row0[3]}.Normalize()
+	f[FrustumBottom] = Plane{row3.Add(row1).Vec3(), row3[3] + row1[3]}.Normalize()
+	f[FrustumTop] = Plane{row3.Sub(row1).Vec3(), row3[3] - row1[3]}.Normalize()
+	f[FrustumNear] = Plane{row3.Add(row2).Vec3(), row3[3] + row2[3]}.Normalize()
+	f[FrustumFar] = Plane{row3.Sub(row2).Vec3(), row3[3] - row2[3]}.Normalize()
+
+	return f
+}
+
+// FrustumCorners returns the eight corners of the volume clipped by the
+// matrix m, in the same space ExtractFrustumPlanes would return planes in. The
+// corners are found by transforming the corners of the clip space cube by the
+// inverse of m, and are ordered like AABB3.Corners: bit 0 of the index selects
+// right (if set) or left, bit 1 top or bottom, and bit 2 far or near. Thus the
+// first four corners are on the near plane, and corner i+4 is at the far end of
+// the edge starting at corner i.
+//
+// This is useful to fit shadow maps to the view frustum. Since the edges are
+// straight, the corners of a slice of the frustum (a shadow cascade) can be
+// found by linearly interpolating between corner i and corner i+4.
+//
+// If m is not invertible, the result is undefined.
+func FrustumCorners(m Mat4) [8]Vec3 {
+	inv := m.Inv()
+
+	var c [8]Vec3
+	for i := range c {
+		ndc := Vec3{-1, -1, -1}
+		for j := 0; j < 3; j++ {
+			if i&(1<<uint(j)) != 0 {
+				ndc[j] = 1
+			}
+		}
+		c[i] = TransformCoordinate(ndc, inv)
+	}
+
+	return c
+}
+
+// ClassifyPoint returns Inside if p is strictly inside the frustum, Outside
+// if it's outside of it, and Intersecting if it lies exactly on one of the
+// planes.
+func (f FrustumPlanes) ClassifyPoint(p Vec3) Containment {
+	result := Inside
+	for _, plane := range f {
+		d := plane.SignedDistance(p)
+		if d < 0 {
+			return Outside
+		} else if d == 0 {
+			result = Intersecting
+		}
+	}
+
+	return result
+}
+
+// ClassifySphere returns whether the sphere of the given center and radius is
+// inside, outside or intersecting the frustum.
+//
+// Like most frustum culling tests this is conservative: a sphere near the
+// corner of the frustum may be classified as Intersecting even though it's
+// actually outside, but a sphere classified as Outside is never visible.
+func (f FrustumPlanes) ClassifySphere(center Vec3, radius float32) Containment {
+	result := Inside
+	for _, plane := range f {
+		d := plane.SignedDistance(center)
+		if d < -radius {
+			return Outside
+		} else if d < radius {
+			result = Intersecting
+		}
+	}
+
+	return result
+}
+
+// ClassifyAABB returns whether the box is inside, outside or intersecting the
+// frustum. For each plane, only the corner furthest along the plane's normal
+// (the "positive vertex") and the one furthest against it are tested.
+//
+// Like ClassifySphere this is conservative, a box classified as Outside is
+// never visible but a box classified as Intersecting may not be either. Empty
+// boxes are always Outside.
+func (f FrustumPlanes) ClassifyAABB(b AABB3) Containment {
+	if b.IsEmpty() {
+		return Outside
+	}
+
+	result := Inside
+	for _, plane := range f {
+		pos, neg := b.Max, b.Min
+		for i := 0; i < 3; i++ {
+			if plane.Normal[i] < 0 {
+				pos[i], neg[i] = b.Min[i], b.Max[i]
+			}
+		}
+
+		if plane.SignedDistance(pos) < 0 {
+			return Outside
+		} else if plane.SignedDistance(neg) < 0 {
+			result = Intersecting
+		}
+	}
+
+	return result
+}
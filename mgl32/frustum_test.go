@@ -0,0 +1,129 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExtractFrustumPlanes(t *testing.T) {
+	t.Parallel()
+
+	f := ExtractFrustumPlanes(Perspective(DegToRad(90), 1, 1, 10))
+	s := float32(math.Sqrt2 / 2)
+
+	expected := FrustumPlanes{
+		FrustumLeft:   {Vec3{s, 0, -s}, 0},
+		FrustumRight:  {Vec3{-s, 0, -s}, 0},
+		FrustumBottom: {Vec3{0, s, -s}, 0},
+		FrustumTop:    {Vec3{0, -s, -s}, 0},
+		FrustumNear:   {Vec3{0, 0, -1}, -1},
+		FrustumFar:    {Vec3{0, 0, 1}, 10},
+	}
+
+	for i := range f {
+		if !f[i].Normal.ApproxEqualThreshold(expected[i].Normal, 1e-5) || Abs(f[i].D-expected[i].D) > 1e-4 {
+			t.Errorf("Plane %d of frustum is %v, expected %v", i, f[i], expected[i])
+		}
+	}
+}
+
+func TestFrustumWorldSpace(t *testing.T) {
+	t.Parallel()
+
+	// Camera at (10,0,0) looking down -X
+	view := LookAtV(Vec3{10, 0, 0}, Vec3{0, 0, 0}, Vec3{0, 1, 0})
+	f := ExtractFrustumPlanes(Perspective(DegToRad(60), 1, 1, 100).Mul4(view))
+
+	if c := f.ClassifyPoint(Vec3{0, 0, 0}); c != Inside {
+		t.Errorf("Origin classified as %v, expected Inside", c)
+	}
+	if c := f.ClassifyPoint(Vec3{20, 0, 0}); c != Outside {
+		t.Errorf("Point behind camera classified as %v, expected Outside", c)
+	}
+	if c := f.ClassifyPoint(Vec3{9.5, 0, 0}); c != Outside {
+		t.Errorf("Point before near plane classified as %v, expected Outside", c)
+	}
+	if c := f.ClassifyPoint(Vec3{0, 0, 50}); c != Outside {
+		t.Errorf("Point to the side classified as %v, expected Outside", c)
+	}
+}
+
+func TestFrustumClassifySphere(t *testing.T) {
+	t.Parallel()
+
+	f := ExtractFrustumPlanes(Ortho(-1, 1, -1, 1, 1, 10))
+
+	tests := []struct {
+		Center   Vec3
+		Radius   float32
+		Expected Containment
+	}{
+		{Vec3{0, 0, -5}, 0.5, Inside},
+		{Vec3{0, 0, -5}, 2, Intersecting},
+		{Vec3{1.2, 0, -5}, 0.5, Intersecting},
+		{Vec3{2, 0, -5}, 0.5, Outside},
+		{Vec3{0, 0, -11}, 0.5, Outside},
+		{Vec3{0, 0, -0.75}, 0.5, Intersecting},
+	}
+
+	for _, c := range tests {
+		if r := f.ClassifySphere(c.Center, c.Radius); r != c.Expected {
+			t.Errorf("ClassifySphere(%v, %v) = %v, expected %v", c.Center, c.Radius, r, c.Expected)
+		}
+	}
+}
+
+func TestFrustumClassifyAABB(t *testing.T) {
+	t.Parallel()
+
+	f := ExtractFrustumPlanes(Ortho(-1, 1, -1, 1, 1, 10))
+
+	tests := []struct {
+		Box      AABB3
+		Expected Containment
+	}{
+		{AABB3{Vec3{-0.5, -0.5, -6}, Vec3{0.5, 0.5, -4}}, Inside},
+		{AABB3{Vec3{0.5, -0.5, -6}, Vec3{1.5, 0.5, -4}}, Intersecting},
+		{AABB3{Vec3{-5, -5, -20}, Vec3{5, 5, 0}}, Intersecting},
+		{AABB3{Vec3{1.5, -0.5, -6}, Vec3{2.5, 0.5, -4}}, Outside},
+		{AABB3{Vec3{-0.5, -0.5, 0}, Vec3{0.5, 0.5, 2}}, Outside},
+		{EmptyAABB3(), Outside},
+	}
+
+	for _, c := range tests {
+		if r := f.ClassifyAABB(c.Box); r != c.Expected {
+			t.Errorf("ClassifyAABB(%v) = %v, expected %v", c.Box, r, c.Expected)
+		}
+	}
+}
+
+func TestFrustumCorners(t *testing.T) {
+	t.Parallel()
+
+	corners := FrustumCorners(Perspective(DegToRad(90), 2, 1, 10))
+
+	expected := [8]Vec3{
+		{-2, -1, -1}, {2, -1, -1}, {-2, 1, -1}, {2, 1, -1},
+		{-20, -10, -10}, {20, -10, -10}, {-20, 10, -10}, {20, 10, -10},
+	}
+
+	for i := range corners {
+		if !corners[i].ApproxEqualThreshold(expected[i], 1e-4) {
+			t.Errorf("Corner %d is %v, expected %v", i, corners[i], expected[i])
+		}
+	}
+
+	// All corners lie on the boundary of the frustum
+	f := ExtractFrustumPlanes(Perspective(DegToRad(90), 2, 1, 10))
+	for i, c := range corners {
+		for j, p := range f {
+			if d := p.SignedDistance(c); d < -1e-4 {
+				t.Errorf("Corner %d is outside plane %d by %v", i, j, d)
+			}
+		}
+	}
+}
@@ -0,0 +1,422 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// The maximum number of iterations of GJK and EPA. Both normally stop once
+// they can't get any closer to the answer, but curved shapes only ever let
+// them approach it, so this puts a bound on the work for those. EPA also stops
+// once its estimate of the depth hasn't improved by more than epaRelTolerance
+// in epaMaxStalled iterations.
+const (
+	gjkMaxIterations = 64
+	epaMaxIterations = 1024
+	epaMaxStalled    = 128
+)
+
+// gjkTolerance is the relative change of the squared distance below which GJK
+// is considered to have converged, and epaTolerance the tolerance on the
+// penetration depth relative to the size of the Minkowski difference.
+// epaRelTolerance is the tolerance on the depth relative to itself, which
+// bounds the work spent approaching curved shapes.
+var (
+	gjkTolerance    = 16 * machineEpsilon
+	epaTolerance    = 4 * machineEpsilon
+	epaRelTolerance = float32(1e-6)
+)
+
+// gjkVertex is a vertex of a simplex in the Minkowski difference of two
+// shapes a and b, with the support points it is made of. That is, w = a - b.
+type gjkVertex struct {
+	w, a, b Vec3
+}
+
+// gjkSupport returns the point of the Minkowski difference of a and b that is
+// farthest in the direction dir.
+func gjkSupport(a, b Shape, dir Vec3) gjkVertex {
+	pa, pb := a.Support(dir), b.Support(dir.Mul(-1))
+	return gjkVertex{pa.Sub(pb), pa, pb}
+}
+
+// gjkSimplex is a point, segment, triangle or tetrahedron in the Minkowski
+// difference, with the barycentric coordinates of a point in it.
+type gjkSimplex struct {
+	verts  [4]gjkVertex
+	lambda [4]float32
+	n      int
+}
+
+// gjkPoint returns the simplex made of the single vertex a.
+func gjkPoint(a gjkVertex) gjkSimplex {
+	return gjkSimplex{verts: [4]gjkVertex{a}, lambda: [4]float32{1}, n: 1}
+}
+
+// gjkSegment returns the segment from a to b, with the point at num/denom
+// along it.
+func gjkSegment(a, b gjkVertex, num, denom float32) gjkSimplex {
+	var t float32
+	if denom > 0 {
+		t = num / denom
+	}
+	return gjkSimplex{verts: [4]gjkVertex{a, b}, lambda: [4]float32{1 - t, t}, n: 2}
+}
+
+// point returns the point of the simplex given by its barycentric
+// coordinates, together with the corresponding points on both shapes.
+func (s *gjkSimplex) point() (w, a, b Vec3) {
+	for i := 0; i < s.n; i++ {
+		w = w.Add(s.verts[i].w.Mul(s.lambda[i]))
+		a = a.Add(s.verts[i].a.Mul(s.lambda[i]))
+		b = b.Add(s.verts[i].b.Mul(s.lambda[i]))
+	}
+	return w, a, b
+}
+
+// closest returns the smallest part of the simplex, a vertex, an edge, a face
+// or the whole of it, that contains the point closest to the origin, with the
+// coordinates of that point. The whole of a tetrahedron is only returned if
+// the origin is inside of it.
+func (s *gjkSimplex) closest() gjkSimplex {
+	switch s.n {
+	case 1:
+		return gjkPoint(s.verts[0])
+	case 2:
+		return closestOnSegment(s.verts[0], s.verts[1])
+	case 3:
+		return closestOnTriangle(s.verts[0], s.verts[1], s.verts[2])
+	}
+
+	// Check the faces that have the origin on their outer side, which are
+	// those with the origin and the opposite vertex on different sides of
+	// their plane
+	faces := [4][4]int{{0, 1, 2, 3}, {0, 2, 3, 1}, {0, 3, 1, 2}, {1, 3, 2, 0}}
+	best, bestDist := *s, InfPos
+	for _, f := range faces {
+		a, b, c, d := s.verts[f[0]], s.verts[f[1]], s.verts[f[2]], s.verts[f[3]]
+		n := b.w.Sub(a.w).Cross(c.w.Sub(a.w))
+		if n.Dot(a.w)*n.Dot(d.w.Sub(a.w)) < 0 {
+			continue
+		}
+		tri := closestOnTriangle(a, b, c)
+		if p, _, _ := tri.point(); p.LenSqr() < bestDist {
+			best, bestDist = tri, p.LenSqr()
+		}
+	}
+
+	return best
+}
+
+// closestOnSegment returns the part of the segment from a to b that contains
+// the point closest to the origin.
+func closestOnSegment(a, b gjkVertex) gjkSimplex {
+	ab := b.w.Sub(a.w)
+	t, denom := -a.w.Dot(ab), ab.LenSqr()
+	if t <= 0 {
+		return gjkPoint(a)
+	}
+	if t >= denom {
+		return gjkPoint(b)
+	}
+	return gjkSegment(a, b, t, denom)
+}
+
+// closestOnTriangle returns the part of the triangle a, b, c that contains
+// the point closest to the origin, by finding the Voronoi region of the
+// triangle the origin is in, as in Ericson's "Real-Time Collision Detection"
+// (2005).
+func closestOnTriangle(a, b, c gjkVertex) gjkSimplex {
+	ab, ac := b.w.Sub(a.w), c.w.Sub(a.w)
+	d1, d2 := -ab.Dot(a.w), -ac.Dot(a.w)
+	if d1 <= 0 && d2 <= 0 {
+		return gjkPoint(a)
+	}
+
+	d3, d4 := -ab.Dot(b.w), -ac.Dot(b.w)
+	if d3 >= 0 && d4 <= d3 {
+		return gjkPoint(b)
+	}
+
+	vc := d1*d4 - d3*d2
+	if vc <= 0 && d1 >= 0 && d3 <= 0 {
+		return gjkSegment(a, b, d1, d1-d3)
+	}
+
+	d5, d6 := -ab.Dot(c.w), -ac.Dot(c.w)
+	if d6 >= 0 && d5 <= d6 {
+		return gjkPoint(c)
+	}
+
+	vb := d5*d2 - d1*d6
+	if vb <= 0 && d2 >= 0 && d6 <= 0 {
+		return gjkSegment(a, c, d2, d2-d6)
+	}
+
+	va := d3*d6 - d5*d4
+	if va <= 0 && d4-d3 >= 0 && d5-d6 >= 0 {
+		return gjkSegment(b, c, d4-d3, (d4-d3)+(d5-d6))
+	}
+
+	sum := va + vb + vc
+	if sum <= 0 {
+		// The triangle is degenerate, so the closest point is on an edge
+		best := closestOnSegment(a, b)
+		bestPoint, _, _ := best.point()
+		for _, s := range [2]gjkSimplex{closestOnSegment(b, c), closestOnSegment(a, c)} {
+			if p, _, _ := s.point(); p.LenSqr() < bestPoint.LenSqr() {
+				best, bestPoint = s, p
+			}
+		}
+		return best
+	}
+
+	return gjkSimplex{verts: [4]gjkVertex{a, b, c}, lambda: [4]float32{va / sum, vb / sum, vc / sum}, n: 3}
+}
+
+// gjk runs the Gilbert-Johnson-Keerthi algorithm, which searches the
+// Minkowski difference of a and b for the point closest to the origin by
+// refining a simplex in it. The shapes intersect if the difference contains
+// the origin.
+//
+// It returns the final simplex, whose point is the closest one if the shapes
+// don't intersect. If separating is true, it stops as soon as it finds an
+// axis that separates the shapes, without looking for the closest point.
+func gjk(a, b Shape, separating bool) (s gjkSimplex, intersect bool) {
+	s = gjkPoint(gjkSupport(a, b, Vec3{1, 0, 0}))
+	v := s.verts[0].w
+	for i := 0; i < gjkMaxIterations; i++ {
+		vv := v.LenSqr()
+		if vv == 0 {
+			return s, true
+		}
+
+		w := gjkSupport(a, b, v.Mul(-1))
+		vw := v.Dot(w.w)
+		if separating && vw > 0 {
+			return s, false
+		}
+		if vv-vw <= gjkTolerance*vv {
+			return s, false
+		}
+		for j := 0; j < s.n; j++ {
+			if s.verts[j].w == w.w {
+				return s, false
+			}
+		}
+
+		next := s
+		next.verts[next.n] = w
+		next.n++
+		next = next.closest()
+		if next.n == 4 {
+			return next, true
+		}
+
+		// The origin is on the simplex if the closest point is as close to it
+		// as the rounding errors of the vertices
+		nv, _, _ := next.point()
+		var maxLenSqr float32
+		for j := 0; j < next.n; j++ {
+			l := next.verts[j].w.LenSqr()
+			SetMax(&maxLenSqr, &l)
+		}
+		if tol := 16 * machineEpsilon; nv.LenSqr() <= tol*tol*maxLenSqr {
+			return next, true
+		}
+
+		// Rounding errors can keep GJK from getting any closer
+		if nv.LenSqr() >= vv {
+			return s, false
+		}
+		s, v = next, nv
+	}
+
+	return s, false
+}
+
+// GJKIntersects checks if the convex shapes a and b overlap or touch, using
+// the Gilbert-Johnson-Keerthi algorithm. This stops as soon as it finds a
+// separating axis, so it is faster than GJKDistance for shapes that are far
+// apart.
+func GJKIntersects(a, b Shape) bool {
+	_, intersect := gjk(a, b, true)
+	return intersect
+}
+
+// GJKDistance returns the distance between the convex shapes a and b, and the
+// closest points on each of them, using the Gilbert-Johnson-Keerthi
+// algorithm. If the shapes intersect, ok is false and the points are
+// undefined, see EPAPenetration in that case.
+//
+// For shapes with flat faces the result is exact up to rounding errors, while
+// for curved ones it converges to within about 100 times the machine
+// precision, relative to the larger of the distance and the size of the shapes.
+// The closest points are not unique when faces or edges
+// of the shapes are parallel, and then any pair of them may be returned.
+func GJKDistance(a, b Shape) (dist float32, pointA, pointB Vec3, ok bool) {
+	s, intersect := gjk(a, b, false)
+	if intersect {
+		return 0, Vec3{}, Vec3{}, false
+	}
+
+	v, pointA, pointB := s.point()
+	return v.Len(), pointA, pointB, true
+}
+
+// EPAPenetration returns how deep the convex shapes a and b are penetrating
+// each other, using GJK to detect the intersection and the Expanding Polytope
+// Algorithm to find the penetration. If the shapes don't intersect, ok is
+// false.
+//
+// The normal is a unit vector that points from a towards b, such that moving
+// b by normal.Mul(depth), or a by the opposite, makes the shapes just touch.
+// This is the shortest such translation, for the most part: for curved shapes
+// the polytope only approaches them within the iterations it is given, and
+// the depth is only found to within about 2e-5 times the size of the shapes.
+// The depth changes slowly with the direction close to the shortest one, so
+// the normal is only accurate to about 0.02.
+func EPAPenetration(a, b Shape) (normal Vec3, depth float32, ok bool) {
+	s, intersect := gjk(a, b, false)
+	if !intersect {
+		return Vec3{}, 0, false
+	}
+
+	verts := make([]Vec3, s.n, 4+epaMaxIterations)
+	var scale float32
+	for i := range verts {
+		verts[i] = s.verts[i].w
+		l := verts[i].Len()
+		SetMax(&scale, &l)
+	}
+	eps := epaTolerance * scale
+
+	// GJK may stop with fewer than 4 vertices when the origin is on the
+	// simplex, so blow it up to a tetrahedron by searching for vertices off
+	// the point, line or plane. If there are none, the Minkowski difference is
+	// flat and the shapes only touch.
+	normal = Vec3{1, 0, 0}
+	if len(verts) == 1 {
+		for _, dir := range [6]Vec3{{1, 0, 0}, {-1, 0, 0}, {0, 1, 0}, {0, -1, 0}, {0, 0, 1}, {0, 0, -1}} {
+			if w := gjkSupport(a, b, dir).w; w.Sub(verts[0]).Len() > eps {
+				verts = append(verts, w)
+				break
+			}
+		}
+	}
+	if len(verts) == 2 {
+		line := verts[1].Sub(verts[0]).Normalize()
+		normal = perpendicular(line)
+		rot := QuatRotate(math.Pi/3, line)
+		for i := 0; i < 6; i++ {
+			if w := gjkSupport(a, b, normal).w; w.Sub(verts[0]).Cross(line).Len() > eps {
+				verts = append(verts, w)
+				break
+			}
+			normal = rot.Rotate(normal)
+		}
+	}
+	if len(verts) == 3 {
+		normal = verts[1].Sub(verts[0]).Cross(verts[2].Sub(verts[0])).Normalize()
+		for _, dir := range [2]Vec3{normal, normal.Mul(-1)} {
+			if w := gjkSupport(a, b, dir).w; Abs(normal.Dot(w.Sub(verts[0]))) > eps {
+				verts = append(verts, w)
+				break
+			}
+		}
+	}
+	if len(verts) < 4 {
+		return normal, 0, true
+	}
+
+	// Build the polytope from the tetrahedron, with its faces wound away from
+	// its centroid. Faces too small to have a normal are never closest to the
+	// origin.
+	var faces []hullFace
+	addFace := func(i, j, k int) {
+		f := hullFace{v: [3]int{i, j, k}, offset: InfPos}
+		n := verts[j].Sub(verts[i]).Cross(verts[k].Sub(verts[i]))
+		if l := n.Len(); l > 0 {
+			f.normal = n.Mul(1 / l)
+			f.offset = f.normal.Dot(verts[i])
+		}
+		faces = append(faces, f)
+	}
+	centroid := verts[0].Add(verts[1]).Add(verts[2]).Add(verts[3]).Mul(0.25)
+	for i := 0; i < 4; i++ {
+		a, b, c := (i+1)%4, (i+2)%4, (i+3)%4
+		n := verts[b].Sub(verts[a]).Cross(verts[c].Sub(verts[a]))
+		if n.Dot(verts[a].Sub(centroid)) < 0 {
+			b, c = c, b
+		}
+		addFace(a, b, c)
+	}
+
+	// Expand the polytope towards the surface of the Minkowski difference
+	// behind the face closest to the origin. The distance to that face is a
+	// lower bound on the depth, and the distance to the support plane in the
+	// direction of its normal an upper bound, which is much closer for curved
+	// shapes. It stops when they meet, or the upper bound stops improving.
+	var edges [][2]int
+	depth, stalled := InfPos, 0
+	for i := 0; i < epaMaxIterations && stalled < epaMaxStalled; i++ {
+		closest := -1
+		for j := range faces {
+			if !faces[j].dead && (closest < 0 || faces[j].offset < faces[closest].offset) {
+				closest = j
+			}
+		}
+		f := faces[closest]
+
+		w := gjkSupport(a, b, f.normal).w
+		d := f.normal.Dot(w)
+		if d < depth*(1-epaRelTolerance) {
+			stalled = 0
+		} else {
+			stalled++
+		}
+		if d < depth {
+			normal, depth = f.normal, d
+		}
+		if depth-f.offset <= eps+epaRelTolerance*depth {
+			break
+		}
+		verts = append(verts, w)
+
+		// Remove the faces that can see the new vertex, keeping the edges
+		// around the hole they leave, which are those that are not shared by
+		// two of them
+		edges = edges[:0]
+		for j := range faces {
+			if faces[j].dead || faces[j].dist(w) <= 0 {
+				continue
+			}
+			faces[j].dead = true
+			for e := 0; e < 3; e++ {
+				edge := [2]int{faces[j].v[e], faces[j].v[(e+1)%3]}
+				shared := false
+				for k, other := range edges {
+					if other[0] == edge[1] && other[1] == edge[0] {
+						edges = append(edges[:k], edges[k+1:]...)
+						shared = true
+						break
+					}
+				}
+				if !shared {
+					edges = append(edges, edge)
+				}
+			}
+		}
+		for _, e := range edges {
+			addFace(e[0], e[1], len(verts)-1)
+		}
+	}
+
+	if depth < 0 {
+		depth = 0
+	}
+	return normal, depth, true
+}
@@ -0,0 +1,191 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSupport(t *testing.T) {
+	t.Parallel()
+
+	box := OBB{Vec3{1, 2, 3}, HomogRotate3D(0.5, Vec3{1, 2, 3}.Normalize()).Mat3(), Vec3{2, 1, 0.5}}
+	c := box.Corners()
+	hull := NewConvexHull(append(c[:], box.Center))
+	if len(hull.Vertices) != 8 {
+		t.Errorf("NewConvexHull kept %d vertices of a box, expected 8", len(hull.Vertices))
+	}
+	// The unit cube hull transformed by the matrix of the box is the box
+	cube := AABB3{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}.Corners()
+	unit := NewConvexHull(cube[:])
+	transformed := TransformedShape{unit, box.Mat4()}
+	capsule := Capsule{Vec3{0, 0, 0}, Vec3{0, 4, 0}, 1}
+
+	r := rand.New(rand.NewSource(1))
+	for _, dir := range randVec3s(r, 50) {
+		expected := box.Support(dir)
+		if p := hull.Support(dir); !p.ApproxEqualThreshold(expected, 1e-5) {
+			t.Errorf("ConvexHull Support(%v) = %v, expected %v", dir, p, expected)
+		}
+		if p := transformed.Support(dir); !p.ApproxEqualThreshold(expected, 1e-5) {
+			t.Errorf("TransformedShape Support(%v) = %v, expected %v", dir, p, expected)
+		}
+		if p := (AABB3{Vec3{0, 1, 2}, Vec3{3, 4, 5}}).Support(dir); !OBBFromAABB(AABB3{Vec3{0, 1, 2}, Vec3{3, 4, 5}}).Support(dir).ApproxEqual(p) {
+			t.Errorf("AABB3 Support(%v) = %v doesn't match the OBB", dir, p)
+		}
+
+		end := Vec3{}
+		if dir[1] > 0 {
+			end = Vec3{0, 4, 0}
+		}
+		if p := capsule.Support(dir); !p.ApproxFuncEqual(end.Add(dir.Normalize()), within(1e-5)) {
+			t.Errorf("Capsule Support(%v) = %v", dir, p)
+		}
+	}
+}
+
+// The accuracies documented by GJKDistance and EPAPenetration for curved
+// shapes, relative to the size of the shapes for the distance and depth
+var (
+	gjkDistTolerance   = 100 * machineEpsilon
+	epaDepthTolerance  = float32(2e-5)
+	epaNormalTolerance = float32(2e-2)
+)
+
+func TestGJK(t *testing.T) {
+	t.Parallel()
+
+	unit := Sphere{Vec3{}, 1}
+	cube := AABB3{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}
+	tests := []struct {
+		A, B           Shape
+		Dist           float32
+		PointA, PointB Vec3
+	}{
+		{unit, Sphere{Vec3{3, 4, 0}, 2}, 2, Vec3{0.6, 0.8, 0}, Vec3{1.8, 2.4, 0}},
+		{cube, Sphere{Vec3{0.5, 0, 3}, 1}, 1, Vec3{0.5, 0, 1}, Vec3{0.5, 0, 2}},
+		{cube, AABB3{Vec3{3, 2, 3}, Vec3{4, 3, 4}}, 3, Vec3{1, 1, 1}, Vec3{3, 2, 3}},
+		{Capsule{Vec3{-5, 0, 0}, Vec3{5, 0, 0}, 0.5}, Capsule{Vec3{2, -3, 2}, Vec3{2, 3, 2}, 0.5}, 1, Vec3{2, 0, 0.5}, Vec3{2, 0, 1.5}},
+		{unit, TransformedShape{unit, Translate3D(0, 0, 5).Mul4(Scale3D(1, 1, 2))}, 2, Vec3{0, 0, 1}, Vec3{0, 0, 3}},
+	}
+	for _, test := range tests {
+		dist, pa, pb, ok := GJKDistance(test.A, test.B)
+		if !ok || Abs(dist-test.Dist) > gjkDistTolerance*test.Dist {
+			t.Errorf("GJKDistance(%v, %v) = %v, %v, expected %v", test.A, test.B, dist, ok, test.Dist)
+		}
+		if !pa.ApproxFuncEqual(test.PointA, within(1e-3)) || !pb.ApproxFuncEqual(test.PointB, within(1e-3)) {
+			t.Errorf("GJKDistance(%v, %v) points = %v, %v, expected %v, %v", test.A, test.B, pa, pb, test.PointA, test.PointB)
+		}
+		if GJKIntersects(test.A, test.B) {
+			t.Errorf("GJKIntersects(%v, %v) = true", test.A, test.B)
+		}
+	}
+
+	overlapping := [][2]Shape{
+		{unit, unit},
+		{unit, Sphere{Vec3{1.9, 0, 0}, 1}},
+		{cube, AABB3{Vec3{1, 1, 1}, Vec3{2, 2, 2}}},
+		{cube, Capsule{Vec3{-5, 0, 0}, Vec3{5, 0, 0}, 0.1}},
+	}
+	for _, test := range overlapping {
+		if !GJKIntersects(test[0], test[1]) {
+			t.Errorf("GJKIntersects(%v, %v) = false", test[0], test[1])
+		}
+		if _, _, _, ok := GJKDistance(test[0], test[1]); ok {
+			t.Errorf("GJKDistance(%v, %v) found a distance between intersecting shapes", test[0], test[1])
+		}
+	}
+
+	// Disjoint spheres, which GJK can only approach
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		ra, rb := 0.5+2*r.Float32(), 0.5+2*r.Float32()
+		dir := Vec3{float32(r.NormFloat64()), float32(r.NormFloat64()), float32(r.NormFloat64())}.Normalize()
+		a := Sphere{Vec3{r.Float32()*4 - 2, r.Float32()*4 - 2, r.Float32()*4 - 2}, ra}
+		b := Sphere{a.Center.Add(dir.Mul((1.01 + 3*r.Float32()) * (ra + rb))), rb}
+		expected := b.Center.Sub(a.Center).Len() - ra - rb
+		size := ra + rb
+		SetMax(&size, &expected)
+		if dist, _, _, ok := GJKDistance(a, b); !ok || Abs(dist-expected) > gjkDistTolerance*size {
+			t.Errorf("GJKDistance(%v, %v) = %v, %v, expected %v", a, b, dist, ok, expected)
+		}
+	}
+
+	// Compare with the separating axis test of oriented boxes
+	r = rand.New(rand.NewSource(2))
+	for i := 0; i < 300; i++ {
+		b1, b2 := randOBB(r), randOBB(r)
+		if GJKIntersects(b1, b2) != b1.Intersects(b2) {
+			t.Errorf("GJKIntersects(%v, %v) = %v, expected %v", b1, b2, !b1.Intersects(b2), b1.Intersects(b2))
+		}
+		if dist, pa, pb, ok := GJKDistance(b1, b2); ok {
+			if !FloatEqualThreshold(pb.Sub(pa).Len(), dist, 1e-4) || b1.DistSqr(pa) > 1e-6 || b2.DistSqr(pb) > 1e-6 {
+				t.Errorf("GJKDistance(%v, %v) = %v between %v and %v", b1, b2, dist, pa, pb)
+			}
+		}
+	}
+}
+
+func TestEPAPenetration(t *testing.T) {
+	t.Parallel()
+
+	cube := AABB3{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}
+	tests := []struct {
+		A, B   Shape
+		Normal Vec3
+		Depth  float32
+	}{
+		{Sphere{Vec3{}, 1}, Sphere{Vec3{1.5, 0, 0}, 1}, Vec3{1, 0, 0}, 0.5},
+		{Sphere{Vec3{1, 1, 1}, 2}, Sphere{Vec3{1, 1, -1}, 1}, Vec3{0, 0, -1}, 1},
+		{cube, AABB3{Vec3{0.8, -0.5, -0.5}, Vec3{2, 0.5, 0.5}}, Vec3{1, 0, 0}, 0.2},
+		{cube, AABB3{Vec3{-3, -3, 0.5}, Vec3{3, 3, 3}}, Vec3{0, 0, 1}, 0.5},
+		{cube, Sphere{Vec3{0, -1.5, 0}, 1}, Vec3{0, -1, 0}, 0.5},
+		{Capsule{Vec3{0, 0, 0}, Vec3{0, 3, 0}, 1}, OBB{Vec3{1.5, 1, 0}, HomogRotate3DZ(0.3).Mat3(), Vec3{0.1, 5, 5}}, Vec3{float32(math.Cos(0.3)), float32(math.Sin(0.3)), 0}, 1.1 + 2*float32(math.Sin(0.3)) - 1.5*float32(math.Cos(0.3))},
+	}
+	for _, test := range tests {
+		normal, depth, ok := EPAPenetration(test.A, test.B)
+		if !ok || Abs(depth-test.Depth) > epaDepthTolerance || normal.Sub(test.Normal).Len() > epaNormalTolerance {
+			t.Errorf("EPAPenetration(%v, %v) = %v, %v, %v, expected %v, %v", test.A, test.B, normal, depth, ok, test.Normal, test.Depth)
+		}
+
+		// Moving b out by the penetration makes the shapes just touch
+		offset := normal.Mul(depth + 1e-2)
+		moved := TransformedShape{test.B, Translate3D(offset[0], offset[1], offset[2])}
+		if dist, _, _, ok := GJKDistance(test.A, moved); !ok || Abs(dist-1e-2) > 1e-3 {
+			t.Errorf("Shapes moved apart by EPAPenetration(%v, %v) are %v apart, %v", test.A, test.B, dist, ok)
+		}
+	}
+
+	// Concentric shapes, where GJK ends with the origin on a vertex, and every
+	// direction is as short as any other for spheres
+	for _, r := range []float32{0.5, 1, 2} {
+		if normal, depth, ok := EPAPenetration(Sphere{Vec3{}, 1}, Sphere{Vec3{}, r}); !ok || Abs(depth-(1+r)) > epaDepthTolerance*(1+r) || !FloatEqualThreshold(normal.Len(), 1, 1e-5) {
+			t.Errorf("EPAPenetration of concentric spheres of radius 1 and %v = %v, %v, %v", r, normal, depth, ok)
+		}
+	}
+	if normal, depth, ok := EPAPenetration(cube, cube); !ok || Abs(depth-2) > epaDepthTolerance*2 || !FloatEqualThreshold(normal.Len(), 1, 1e-5) {
+		t.Errorf("EPAPenetration of the same cube = %v, %v, %v", normal, depth, ok)
+	}
+
+	if _, _, ok := EPAPenetration(cube, Sphere{Vec3{3, 0, 0}, 1}); ok {
+		t.Errorf("EPAPenetration found disjoint shapes to intersect")
+	}
+
+	// Overlapping spheres, which the polytope can only approach
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		ra, rb := 0.5+2*r.Float32(), 0.5+2*r.Float32()
+		dir := Vec3{float32(r.NormFloat64()), float32(r.NormFloat64()), float32(r.NormFloat64())}.Normalize()
+		a := Sphere{Vec3{r.Float32()*4 - 2, r.Float32()*4 - 2, r.Float32()*4 - 2}, ra}
+		b := Sphere{a.Center.Add(dir.Mul((0.1 + 0.85*r.Float32()) * (ra + rb))), rb}
+		expected := ra + rb - b.Center.Sub(a.Center).Len()
+		normal, depth, ok := EPAPenetration(a, b)
+		if !ok || Abs(depth-expected) > epaDepthTolerance*(ra+rb) || normal.Sub(dir).Len() > epaNormalTolerance {
+			t.Errorf("EPAPenetration(%v, %v) = %v, %v, %v, expected %v, %v", a, b, normal, depth, ok, dir, expected)
+		}
+	}
+}
@@ -0,0 +1,459 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/big"
+	"sort"
+)
+
+// ConvexHull2D returns the convex hull of points as a polygon in
+// counter-clockwise order, using Andrew's monotone chain algorithm in
+// O(n log n) time.
+//
+// The polygon starts at the point with the lowest X coordinate, and the lowest
+// Y among those. Duplicate points and points in the middle of an edge of the
+// hull, up to a small tolerance scaled by the magnitude of the coordinates,
+// are left out. So if all points are the same the result has a single point,
+// and if they're collinear it has the two ends of the line. The points slice
+// itself is not modified.
+func ConvexHull2D(points []Vec2) []Vec2 {
+	var maxAbs Vec2
+	for _, p := range points {
+		p = p.Abs()
+		SetMax(&maxAbs[0], &p[0])
+		SetMax(&maxAbs[1], &p[1])
+	}
+	indices := convexHull2D(points, 16*machineEpsilon*(maxAbs[0]+maxAbs[1]))
+	if indices == nil {
+		return nil
+	}
+
+	hull := make([]Vec2, len(indices))
+	for i, index := range indices {
+		hull[i] = points[index]
+	}
+	return hull
+}
+
+// convexHull2D returns the indices of the points on the convex hull in
+// counter-clockwise order, see ConvexHull2D. Points within eps of the line
+// between their neighbors on the hull are left out.
+func convexHull2D(points []Vec2, eps float32) []int {
+	if len(points) == 0 {
+		return nil
+	}
+
+	order := make([]int, len(points))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		a, b := points[order[i]], points[order[j]]
+		return a[0] < b[0] || (a[0] == b[0] && a[1] < b[1])
+	})
+
+	// Drop the duplicates, which are now next to each other
+	unique := order[:1]
+	for _, index := range order[1:] {
+		if points[index] != points[unique[len(unique)-1]] {
+			unique = append(unique, index)
+		}
+	}
+	if len(unique) < 3 {
+		return unique
+	}
+
+	// turnsLeft checks if the path a, b, c makes a left turn, with b farther
+	// than eps from the line through a and c
+	turnsLeft := func(a, b, c int) bool {
+		ab, ac := points[b].Sub(points[a]), points[c].Sub(points[a])
+		return ab[0]*ac[1]-ab[1]*ac[0] > eps*ac.Len()
+	}
+
+	// Build the lower hull from left to right, then the upper hull back from
+	// right to left on top of it. Each chain ends at the first point of the
+	// other one, so the last point is left out.
+	hull := make([]int, 0, 2*len(unique))
+	for _, index := range unique {
+		for len(hull) >= 2 && !turnsLeft(hull[len(hull)-2], hull[len(hull)-1], index) {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, index)
+	}
+	lower := len(hull) + 1
+	for i := len(unique) - 2; i >= 0; i-- {
+		for len(hull) >= lower && !turnsLeft(hull[len(hull)-2], hull[len(hull)-1], unique[i]) {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, unique[i])
+	}
+
+	return hull[:len(hull)-1]
+}
+
+// hullFace is a triangle on the surface of a 3D convex hull being built. Its
+// vertices are in counter-clockwise order seen from the outside, and outside
+// holds the points that are in front of its plane and not yet assigned to
+// another face.
+type hullFace struct {
+	v       [3]int
+	normal  Vec3
+	offset  float32
+	outside []int
+	dead    bool
+}
+
+// dist returns the signed distance from the plane of the face to p.
+func (f *hullFace) dist(p Vec3) float32 {
+	return f.normal.Dot(p) - f.offset
+}
+
+// ConvexHull3D returns the convex hull of points as a closed triangle mesh,
+// using the Quickhull algorithm in expected O(n log n) time.
+//
+// The mesh is made of the vertices of the hull, which are a subset of points
+// in the order they appear in it, and indices, where each three consecutive
+// elements are the indices in vertices of the corners of a triangle. The
+// triangles are wound counter-clockwise seen from the outside of the hull, so
+// their normals point outwards.
+//
+// Points that are within a small tolerance of the surface, scaled by the
+// magnitude of the coordinates, are considered on it rather than outside, so
+// duplicate points and points in the middle of a face don't become vertices.
+// Faces of the hull are made of several triangles. Which faces a new vertex
+// replaces is decided exactly though, so the hull stays convex where many
+// points are nearly coplanar, such as on the side of a cylinder.
+//
+// If all points are coplanar, the vertices are the corners of the flat convex
+// polygon around them in order, and it is triangulated with triangles facing
+// both sides of the plane. If they are collinear, or all the same, the result
+// is the two ends of the line or the single point, without any triangles. The
+// points slice itself is not modified.
+func ConvexHull3D(points []Vec3) (vertices []Vec3, indices []int) {
+	if len(points) == 0 {
+		return nil, nil
+	}
+
+	// The tolerance for points to be in front of a plane, relative to the
+	// extent of the coordinates as in Qhull
+	var maxAbs Vec3
+	for _, p := range points {
+		p = p.Abs()
+		for i := range p {
+			SetMax(&maxAbs[i], &p[i])
+		}
+	}
+	eps := 16 * machineEpsilon * (maxAbs[0] + maxAbs[1] + maxAbs[2])
+
+	// farther checks if the point p at the distance d from a line or plane is
+	// farther than the best one so far. Near ties are broken by the distance
+	// from ref, so that the choice is a corner of the hull rather than a point
+	// in the middle of one of its edges or faces.
+	farther := func(p int, d float32, best int, bestDist float32, ref Vec3) bool {
+		if best < 0 || d > bestDist+eps {
+			return true
+		}
+		return d >= bestDist-eps && points[p].Sub(ref).LenSqr() > points[best].Sub(ref).LenSqr()
+	}
+
+	// Find an initial tetrahedron, starting from the two of the extreme points
+	// on each axis that are farthest apart. Ties on an axis are broken by the
+	// next ones, which again gives corners of the hull.
+	var extremes [6]int
+	for i, p := range points {
+		for axis := 0; axis < 3; axis++ {
+			for k := 0; k < 3; k++ {
+				a := (axis + k) % 3
+				if lo := points[extremes[2*axis]][a]; p[a] != lo {
+					if p[a] < lo {
+						extremes[2*axis] = i
+					}
+					break
+				}
+			}
+			for k := 0; k < 3; k++ {
+				a := (axis + k) % 3
+				if hi := points[extremes[2*axis+1]][a]; p[a] != hi {
+					if p[a] > hi {
+						extremes[2*axis+1] = i
+					}
+					break
+				}
+			}
+		}
+	}
+	var v0, v1 int
+	var bestDist float32
+	for _, i := range extremes {
+		for _, j := range extremes {
+			if d := points[j].Sub(points[i]).Len(); d > bestDist {
+				v0, v1, bestDist = i, j, d
+			}
+		}
+	}
+	if bestDist <= eps {
+		return []Vec3{points[v0]}, nil
+	}
+
+	dir := points[v1].Sub(points[v0]).Normalize()
+	v2 := -1
+	for i, p := range points {
+		if d := p.Sub(points[v0]).Cross(dir).Len(); d > eps && farther(i, d, v2, bestDist, points[v0]) {
+			v2, bestDist = i, d
+		}
+	}
+	if v2 < 0 {
+		if v0 > v1 {
+			v0, v1 = v1, v0
+		}
+		return []Vec3{points[v0], points[v1]}, nil
+	}
+
+	normal := points[v1].Sub(points[v0]).Cross(points[v2].Sub(points[v0])).Normalize()
+	v3 := -1
+	for i, p := range points {
+		if d := Abs(normal.Dot(p.Sub(points[v0]))); d > eps && farther(i, d, v3, bestDist, points[v0]) {
+			v3, bestDist = i, d
+		}
+	}
+	if v3 < 0 {
+		return flatHull3D(points, points[v0], dir, normal, eps)
+	}
+
+	var faces []hullFace
+	edges := make(map[[2]int]int)
+	addFace := func(a, b, c int) {
+		n, offset := trianglePlane(points[a], points[b], points[c])
+		faces = append(faces, hullFace{v: [3]int{a, b, c}, normal: n, offset: offset})
+		for i := 0; i < 3; i++ {
+			edges[[2]int{faces[len(faces)-1].v[i], faces[len(faces)-1].v[(i+1)%3]}] = len(faces) - 1
+		}
+	}
+
+	// Wind the faces of the tetrahedron away from the opposite corner
+	tetra := [4]int{v0, v1, v2, v3}
+	for i := range tetra {
+		a, b, c := tetra[(i+1)%4], tetra[(i+2)%4], tetra[(i+3)%4]
+		n := points[b].Sub(points[a]).Cross(points[c].Sub(points[a]))
+		if n.Dot(points[tetra[i]].Sub(points[a])) > 0 {
+			b, c = c, b
+		}
+		addFace(a, b, c)
+	}
+
+	// assign hands the points out to the first face among faces[first:] that
+	// they are in front of. Points that are behind all of them are inside the
+	// hull and dropped.
+	assign := func(candidates []int, first int) {
+		for _, i := range candidates {
+			for j := first; j < len(faces); j++ {
+				if faces[j].dist(points[i]) > eps {
+					faces[j].outside = append(faces[j].outside, i)
+					break
+				}
+			}
+		}
+	}
+	candidates := make([]int, 0, len(points))
+	for i := range points {
+		if i != v0 && i != v1 && i != v2 && i != v3 {
+			candidates = append(candidates, i)
+		}
+	}
+	assign(candidates, 0)
+
+	var visible, horizon []int
+	for i := 0; i < len(faces); i++ {
+		if faces[i].dead || len(faces[i].outside) == 0 {
+			continue
+		}
+
+		// The next vertex is the point farthest in front of the face
+		f := &faces[i]
+		centroid := points[f.v[0]].Add(points[f.v[1]]).Add(points[f.v[2]]).Mul(1.0 / 3)
+		eye, eyeDist := -1, float32(0)
+		for _, p := range f.outside {
+			if d := f.dist(points[p]); farther(p, d, eye, eyeDist, centroid) {
+				eye, eyeDist = p, d
+			}
+		}
+
+		// Find the faces that can see the eye point, which form a connected
+		// region around this one, and the edges around that region
+		visible = append(visible[:0], i)
+		f.dead = true
+		horizon = horizon[:0]
+		for k := 0; k < len(visible); k++ {
+			f := &faces[visible[k]]
+			for e := 0; e < 3; e++ {
+				a, b := f.v[e], f.v[(e+1)%3]
+				neighbor := edges[[2]int{b, a}]
+				if faces[neighbor].dead {
+					continue
+				}
+				if n := &faces[neighbor]; orient3D(points[n.v[0]], points[n.v[1]], points[n.v[2]], points[eye]) > 0 {
+					faces[neighbor].dead = true
+					visible = append(visible, neighbor)
+				} else {
+					horizon = append(horizon, a, b)
+				}
+			}
+		}
+
+		// Replace the visible faces with a cone from the horizon to the eye
+		// point, keeping the winding of each horizon edge, and give their
+		// points to the new faces
+		candidates = candidates[:0]
+		for _, k := range visible {
+			f := &faces[k]
+			for e := 0; e < 3; e++ {
+				delete(edges, [2]int{f.v[e], f.v[(e+1)%3]})
+			}
+			for _, p := range f.outside {
+				if p != eye {
+					candidates = append(candidates, p)
+				}
+			}
+			f.outside = nil
+		}
+		first := len(faces)
+		for e := 0; e < len(horizon); e += 2 {
+			addFace(horizon[e], horizon[e+1], eye)
+		}
+		assign(candidates, first)
+	}
+
+	// Gather the vertices of the remaining faces, keeping the order of points
+	remap := make(map[int]int)
+	for _, f := range faces {
+		if !f.dead {
+			for _, v := range f.v {
+				remap[v] = 0
+			}
+		}
+	}
+	used := make([]int, 0, len(remap))
+	for v := range remap {
+		used = append(used, v)
+	}
+	sort.Ints(used)
+	vertices = make([]Vec3, len(used))
+	for i, v := range used {
+		vertices[i] = points[v]
+		remap[v] = i
+	}
+	for _, f := range faces {
+		if !f.dead {
+			indices = append(indices, remap[f.v[0]], remap[f.v[1]], remap[f.v[2]])
+		}
+	}
+
+	return vertices, indices
+}
+
+// flatHull3D returns the convex hull of points that all lie in the plane
+// through origin with the given normal, as a polygon triangulated on both
+// sides. dir is a unit vector in the plane, and points within eps of the
+// edges of the polygon are left out.
+func flatHull3D(points []Vec3, origin, dir, normal Vec3, eps float32) (vertices []Vec3, indices []int) {
+	side := normal.Cross(dir)
+	flat := make([]Vec2, len(points))
+	for i, p := range points {
+		d := p.Sub(origin)
+		flat[i] = Vec2{d.Dot(dir), d.Dot(side)}
+	}
+
+	// The polygon is counter-clockwise around the normal, so the fan from its
+	// first vertex faces the normal, and the reversed one faces away from it
+	hull := convexHull2D(flat, eps)
+	vertices = make([]Vec3, len(hull))
+	for i, index := range hull {
+		vertices[i] = points[index]
+	}
+	for i := 1; i+1 < len(hull); i++ {
+		indices = append(indices, 0, i, i+1)
+	}
+	for i := 1; i+1 < len(hull); i++ {
+		indices = append(indices, 0, i+1, i)
+	}
+
+	return vertices, indices
+}
+
+// trianglePlane returns the unit normal of the triangle (a, b, c) and its
+// distance from the origin. They are computed in float64, since the normals of
+// thin triangles lose most of their precision in float32.
+func trianglePlane(a, b, c Vec3) (Vec3, float32) {
+	var u, v [3]float64
+	for i := range u {
+		u[i] = float64(b[i]) - float64(a[i])
+		v[i] = float64(c[i]) - float64(a[i])
+	}
+	n := [3]float64{u[1]*v[2] - u[2]*v[1], u[2]*v[0] - u[0]*v[2], u[0]*v[1] - u[1]*v[0]}
+	l := math.Sqrt(n[0]*n[0] + n[1]*n[1] + n[2]*n[2])
+	if l == 0 {
+		return Vec3{}, 0
+	}
+	var offset float64
+	for i := range n {
+		n[i] /= l
+		offset += n[i] * float64(a[i])
+	}
+	return Vec3{float32(n[0]), float32(n[1]), float32(n[2])}, float32(offset)
+}
+
+// orient3D returns a value with the sign of the volume of the tetrahedron
+// (a, b, c, p), which is positive if p is in front of the triangle (a, b, c)
+// wound counter-clockwise, negative if it's behind it and zero if they are
+// coplanar. The sign is exact: the volume is computed in float64 first, and
+// only if that is too close to zero to be sure of it, again with big.Float.
+func orient3D(a, b, c, p Vec3) float64 {
+	var u, v, w [3]float64
+	for i := range u {
+		u[i] = float64(a[i]) - float64(p[i])
+		v[i] = float64(b[i]) - float64(p[i])
+		w[i] = float64(c[i]) - float64(p[i])
+	}
+	vw0, wv0 := v[1]*w[2], w[1]*v[2]
+	vw1, wv1 := v[2]*w[0], w[2]*v[0]
+	vw2, wv2 := v[0]*w[1], w[0]*v[1]
+	det := u[0]*(vw0-wv0) + u[1]*(vw1-wv1) + u[2]*(vw2-wv2)
+	permanent := math.Abs(u[0])*(math.Abs(vw0)+math.Abs(wv0)) +
+		math.Abs(u[1])*(math.Abs(vw1)+math.Abs(wv1)) +
+		math.Abs(u[2])*(math.Abs(vw2)+math.Abs(wv2))
+	// The error bound of Shewchuk's orient3d, with some margin. There's no
+	// exact volume for infinite or NaN coordinates.
+	const epsilon = 1.0 / (1 << 53)
+	if math.Abs(det) > 8*epsilon*permanent || math.IsNaN(permanent) || math.IsInf(permanent, 0) {
+		return -det
+	}
+
+	// The operations are exact with enough precision for any float64 values,
+	// and only take as many words as the results need
+	const prec = 1 << 14
+	var ur, vr, wr [3]big.Float
+	for i := range ur {
+		pf := new(big.Float).SetFloat64(float64(p[i]))
+		ur[i].SetPrec(prec).SetFloat64(float64(a[i]))
+		ur[i].Sub(&ur[i], pf)
+		vr[i].SetPrec(prec).SetFloat64(float64(b[i]))
+		vr[i].Sub(&vr[i], pf)
+		wr[i].SetPrec(prec).SetFloat64(float64(c[i]))
+		wr[i].Sub(&wr[i], pf)
+	}
+	exact, term := new(big.Float).SetPrec(prec), new(big.Float).SetPrec(prec)
+	x, y := new(big.Float).SetPrec(prec), new(big.Float).SetPrec(prec)
+	for i := 0; i < 3; i++ {
+		j, k := (i+1)%3, (i+2)%3
+		x.Mul(&vr[j], &wr[k])
+		y.Mul(&wr[j], &vr[k])
+		term.Sub(x, y)
+		term.Mul(term, &ur[i])
+		exact.Add(exact, term)
+	}
+	return -float64(exact.Sign())
+}
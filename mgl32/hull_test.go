@@ -0,0 +1,224 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestConvexHull2D(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Points, Hull []Vec2
+	}{
+		{nil, nil},
+		{[]Vec2{{1, 2}, {1, 2}}, []Vec2{{1, 2}}},
+		{[]Vec2{{2, 2}, {0, 0}, {1, 1}, {3, 3}}, []Vec2{{0, 0}, {3, 3}}},
+		{
+			[]Vec2{{1, 1}, {2, 2}, {0, 2}, {1, 0}, {2, 0}, {0, 0}, {2, 2}, {0.5, 1.5}, {0, 1}},
+			[]Vec2{{0, 0}, {2, 0}, {2, 2}, {0, 2}},
+		},
+		{[]Vec2{{0, 0}, {4, 1}, {2, 3}, {1, 1}, {3, 2}}, []Vec2{{0, 0}, {4, 1}, {2, 3}}},
+	}
+	for _, test := range tests {
+		if hull := ConvexHull2D(test.Points); !reflect.DeepEqual(hull, test.Hull) {
+			t.Errorf("ConvexHull2D(%v) = %v, expected %v", test.Points, hull, test.Hull)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	points := make([]Vec2, 500)
+	for i := range points {
+		points[i] = Vec2{r.Float32()*2 - 1, r.Float32()*2 - 1}
+	}
+	hull := ConvexHull2D(points)
+	for i := range hull {
+		a, b := hull[i], hull[(i+1)%len(hull)]
+		edge := b.Sub(a)
+		if c := hull[(i+2)%len(hull)].Sub(a); edge[0]*c[1]-edge[1]*c[0] <= 0 {
+			t.Errorf("ConvexHull2D isn't strictly convex at %v", b)
+		}
+		for _, p := range points {
+			if d := p.Sub(a); edge[0]*d[1]-edge[1]*d[0] < -1e-6 {
+				t.Fatalf("ConvexHull2D point %v is outside the edge from %v to %v", p, a, b)
+			}
+		}
+	}
+}
+
+// checkHull3D checks that vertices and indices form a closed convex mesh with
+// outward facing triangles, and that all points are inside of it up to twice
+// the tolerance of ConvexHull3D. The planes of the triangles are computed in
+// float64, since thin triangles of the hull have inaccurate normals in float32.
+func checkHull3D(t *testing.T, points, vertices []Vec3, indices []int) {
+	edges := make(map[[2]int]int)
+	for i := 0; i < len(indices); i += 3 {
+		for e := 0; e < 3; e++ {
+			edges[[2]int{indices[i+e], indices[i+(e+1)%3]}]++
+		}
+	}
+	for e, count := range edges {
+		if count != 1 || edges[[2]int{e[1], e[0]}] != 1 {
+			t.Fatalf("ConvexHull3D edge %v isn't shared by exactly two triangles", e)
+		}
+	}
+	if faces := len(indices) / 3; len(vertices)-len(edges)/2+faces != 2 {
+		t.Errorf("ConvexHull3D mesh with %d vertices, %d edges and %d faces isn't a sphere", len(vertices), len(edges)/2, faces)
+	}
+
+	var maxAbs Vec3
+	for _, p := range points {
+		p = p.Abs()
+		for i := range p {
+			SetMax(&maxAbs[i], &p[i])
+		}
+	}
+	tolerance := 32 * float64(machineEpsilon) * float64(maxAbs[0]+maxAbs[1]+maxAbs[2])
+
+	sub := func(a, b Vec3) [3]float64 {
+		return [3]float64{float64(a[0]) - float64(b[0]), float64(a[1]) - float64(b[1]), float64(a[2]) - float64(b[2])}
+	}
+	dot := func(a, b [3]float64) float64 {
+		return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+	}
+	var center Vec3
+	for _, v := range vertices {
+		center = center.Add(v)
+	}
+	center = center.Mul(1 / float32(len(vertices)))
+	for i := 0; i < len(indices); i += 3 {
+		a, b, c := vertices[indices[i]], vertices[indices[i+1]], vertices[indices[i+2]]
+		u, v := sub(b, a), sub(c, a)
+		n := [3]float64{u[1]*v[2] - u[2]*v[1], u[2]*v[0] - u[0]*v[2], u[0]*v[1] - u[1]*v[0]}
+		l := math.Sqrt(dot(n, n))
+		if dot(n, sub(center, a)) >= 0 {
+			t.Errorf("ConvexHull3D triangle %v, %v, %v faces inwards", a, b, c)
+		}
+		for _, p := range points {
+			if dot(n, sub(p, a)) > tolerance*l {
+				t.Fatalf("ConvexHull3D point %v is outside the triangle %v, %v, %v", p, a, b, c)
+			}
+		}
+	}
+}
+
+func TestConvexHull3D(t *testing.T) {
+	t.Parallel()
+
+	// A grid filling a box, with all coplanar points on its faces
+	box := OBB{Vec3{1, 2, 3}, HomogRotate3D(0.5, Vec3{1, 2, 3}.Normalize()).Mat3(), Vec3{2, 1, 0.5}}
+	var points []Vec3
+	for x := -2; x <= 2; x++ {
+		for y := -2; y <= 2; y++ {
+			for z := -2; z <= 2; z++ {
+				points = append(points, box.localPoint(Vec3{float32(x), float32(y), float32(z)}.Mul(0.5)))
+			}
+		}
+	}
+	points = append(points, points[:10]...)
+	vertices, indices := ConvexHull3D(points)
+	if len(vertices) != 8 || len(indices) != 36 {
+		t.Errorf("ConvexHull3D of a box has %d vertices and %d triangles, expected 8 and 12", len(vertices), len(indices)/3)
+	}
+	checkHull3D(t, points, vertices, indices)
+	corners := box.Corners()
+	for _, c := range corners {
+		found := false
+		for _, v := range vertices {
+			found = found || v.ApproxEqualThreshold(c, 1e-5)
+		}
+		if !found {
+			t.Errorf("ConvexHull3D of a box is missing the corner %v", c)
+		}
+	}
+
+	r := rand.New(rand.NewSource(2))
+	for _, n := range []int{4, 10, 100, 2000} {
+		points := randVec3s(r, n)
+		vertices, indices := ConvexHull3D(points)
+		checkHull3D(t, points, vertices, indices)
+	}
+
+	// Points on the side of a cylinder, where many points are almost
+	// coplanar with the faces between their neighbors
+	for _, height := range []float32{1, 4} {
+		for i := 0; i < 10; i++ {
+			points := make([]Vec3, 1000)
+			for j := range points {
+				s, c := math.Sincos(r.Float64() * 2 * math.Pi)
+				points[j] = Vec3{float32(c), float32(s), float32(r.Intn(int(height) + 1))}
+			}
+			vertices, indices := ConvexHull3D(points)
+			checkHull3D(t, points, vertices, indices)
+		}
+	}
+
+	// A prism, with alternating points on the top and bottom, some of which
+	// are very close to each other
+	prism := make([]Vec3, 12)
+	for i := range prism {
+		s, c := math.Sincos(float64(i) * 2 * math.Pi / float64(len(prism)))
+		prism[i] = Vec3{float32(c), float32(s), float32(i % 2)}
+	}
+	prism = append(prism, prism[3].Add(Vec3{3e-5, 0, 0}), prism[4].Add(Vec3{0, 2e-5, 0}))
+	vertices, indices = ConvexHull3D(prism)
+	checkHull3D(t, prism, vertices, indices)
+
+	// Points on a sphere are all on the hull
+	points = randVec3s(r, 300)
+	for i := range points {
+		points[i] = points[i].Normalize()
+	}
+	vertices, indices = ConvexHull3D(points)
+	if !reflect.DeepEqual(vertices, points) {
+		t.Errorf("ConvexHull3D of points on a sphere has %d vertices, expected all %d", len(vertices), len(points))
+	}
+	checkHull3D(t, points, vertices, indices)
+}
+
+func TestConvexHull3DDegenerate(t *testing.T) {
+	t.Parallel()
+
+	if v, i := ConvexHull3D(nil); v != nil || i != nil {
+		t.Errorf("ConvexHull3D(nil) = %v, %v", v, i)
+	}
+	if v, i := ConvexHull3D([]Vec3{{1, 2, 3}, {1, 2, 3}}); !reflect.DeepEqual(v, []Vec3{{1, 2, 3}}) || i != nil {
+		t.Errorf("ConvexHull3D of the same point = %v, %v", v, i)
+	}
+	line := []Vec3{{1, 1, 1}, {0, 0, 0}, {3, 3, 3}, {2, 2, 2}}
+	if v, i := ConvexHull3D(line); !reflect.DeepEqual(v, []Vec3{{0, 0, 0}, {3, 3, 3}}) || i != nil {
+		t.Errorf("ConvexHull3D(%v) = %v, %v", line, v, i)
+	}
+
+	// A flat square with points inside, triangulated on both sides
+	m := Translate3D(1, 2, 3).Mul4(HomogRotate3D(1, Vec3{1, 1, 0}.Normalize()))
+	var square []Vec3
+	for x := 0; x <= 4; x++ {
+		for y := 0; y <= 4; y++ {
+			square = append(square, TransformCoordinate(Vec3{float32(x), float32(y), 0}, m))
+		}
+	}
+	vertices, indices := ConvexHull3D(square)
+	if len(vertices) != 4 || len(indices) != 12 {
+		t.Fatalf("ConvexHull3D of a square has %d vertices and %d triangles, expected 4 and 4", len(vertices), len(indices)/3)
+	}
+	normal := m.Mul4x1(Vec4{0, 0, 1, 0}).Vec3()
+	var front, back int
+	for i := 0; i < len(indices); i += 3 {
+		a, b, c := vertices[indices[i]], vertices[indices[i+1]], vertices[indices[i+2]]
+		if n := b.Sub(a).Cross(c.Sub(a)).Normalize(); n.ApproxEqualThreshold(normal, 1e-4) {
+			front++
+		} else if n.ApproxEqualThreshold(normal.Mul(-1), 1e-4) {
+			back++
+		}
+	}
+	if front != 2 || back != 2 {
+		t.Errorf("ConvexHull3D of a square has %d triangles facing the front and %d facing the back, expected 2 and 2", front, back)
+	}
+}
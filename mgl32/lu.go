@@ -0,0 +1,326 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// LU is the LU decomposition of a square MatMxN A with partial pivoting. That
+// is, PA = LU where P is a permutation matrix, L is lower triangular with a unit
+// diagonal and U is upper triangular.
+//
+// Once computed, the decomposition can be used to cheaply find the
+// determinant and inverse of A and to solve linear systems Ax = b for any
+// number of vectors b. L and U are stored together in a single matrix, with the
+// (implicit) unit diagonal of L omitted.
+type LU struct {
+	lu       *MatMxN
+	pivots   []int
+	sign     float32
+	singular bool
+}
+
+// LU computes the LU decomposition of mat with partial pivoting, using
+// Doolittle's algorithm. The decomposition is stored in dst, reusing its
+// memory if possible. If dst is nil, a new LU will be allocated. The value
+// returned is dst.
+//
+// If mat is nil a NilMatrixError is returned, and if it is not square a
+// RectangularMatrixError. A singular matrix still has an LU decomposition, so
+// this will not fail because of singularity; instead, LU.IsSingular will
+// report it, and LU.Solve and LU.Inv will return a SingularMatrixError.
+func (mat *MatMxN) LU(dst *LU) (*LU, error) {
+	if mat == nil {
+		return nil, NilMatrixError{}
+	}
+	if mat.m != mat.n {
+		return nil, RectangularMatrixError{}
+	}
+
+	if dst == nil {
+		dst = &LU{}
+	}
+	if dst.lu == nil {
+		dst.lu = NewMatrix(mat.m, mat.n)
+	}
+	CopyMatMN(dst.lu, mat)
+
+	if cap(dst.pivots) < mat.m {
+		dst.pivots = make([]int, mat.m)
+	}
+	dst.pivots = dst.pivots[:mat.m]
+
+	dst.decompose()
+
+	return dst, nil
+}
+
+// decompose performs the in-place elimination on lu.lu, which must already
+// hold a copy of the matrix being decomposed.
+func (lu *LU) decompose() {
+	a, n := lu.lu, lu.lu.m
+
+	for i := range lu.pivots {
+		lu.pivots[i] = i
+	}
+	lu.sign = 1
+	lu.singular = false
+
+	// Rounding makes the pivots of a singular matrix unlikely to be exactly
+	// zero, so a pivot counts as zero if it's smaller than the largest
+	// element by a factor of about the size times the machine precision
+	var maxAbs float32
+	for _, v := range a.dat[:n*n] {
+		if Abs(v) > maxAbs {
+			maxAbs = Abs(v)
+		}
+	}
+	tol := float32(n) * machineEpsilon * maxAbs
+
+	for k := 0; k < n; k++ {
+		// Partial pivoting: bring the largest element of column k
+		// (on or below the diagonal) to the diagonal
+		p, max := k, Abs(a.At(k, k))
+		for i := k + 1; i < n; i++ {
+			if v := Abs(a.At(i, k)); v > max {
+				p, max = i, v
+			}
+		}
+
+		if p != k {
+			for j := 0; j < n; j++ {
+				a.dat[j*n+p], a.dat[j*n+k] = a.dat[j*n+k], a.dat[j*n+p]
+			}
+			lu.pivots[p], lu.pivots[k] = lu.pivots[k], lu.pivots[p]
+			lu.sign = -lu.sign
+		}
+
+		pivot := a.At(k, k)
+		if Abs(pivot) <= tol {
+			lu.singular = true
+		}
+		if pivot == 0 {
+			// The rest of the column is zero already
+			continue
+		}
+
+		for i := k + 1; i < n; i++ {
+			f := a.At(i, k) / pivot
+			a.Set(i, k, f)
+			for j := k + 1; j < n; j++ {
+				a.Set(i, j, a.At(i, j)-f*a.At(k, j))
+			}
+		}
+	}
+}
+
+// Size returns the number of rows (and columns) of the decomposed matrix.
+func (lu *LU) Size() int {
+	return lu.lu.m
+}
+
+// IsSingular returns whether the decomposed matrix is singular (has a
+// determinant of zero), up to a tolerance relative to its largest element.
+func (lu *LU) IsSingular() bool {
+	return lu.singular
+}
+
+// Pivots returns the row permutation of the decomposition: row i of LU is row
+// Pivots()[i] of the original matrix. The returned slice is shared with the
+// decomposition and must not be modified.
+func (lu *LU) Pivots() []int {
+	return lu.pivots
+}
+
+// L stores the lower triangular factor of the decomposition in dst, which
+// is Reshaped as necessary, and returns it. Its diagonal is all ones.
+func (lu *LU) L(dst *MatMxN) *MatMxN {
+	n := lu.lu.m
+	dst = dst.Reshape(n, n)
+
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			switch {
+			case i == j:
+				dst.Set(i, j, 1)
+			case i > j:
+				dst.Set(i, j, lu.lu.At(i, j))
+			default:
+				dst.Set(i, j, 0)
+			}
+		}
+	}
+
+	return dst
+}
+
+// U stores the upper triangular factor of the decomposition in dst, which
+// is Reshaped as necessary, and returns it.
+func (lu *LU) U(dst *MatMxN) *MatMxN {
+	n := lu.lu.m
+	dst = dst.Reshape(n, n)
+
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			if i <= j {
+				dst.Set(i, j, lu.lu.At(i, j))
+			} else {
+				dst.Set(i, j, 0)
+			}
+		}
+	}
+
+	return dst
+}
+
+// Det returns the determinant of the decomposed matrix, which is the product
+// of the diagonal of U, negated if an odd number of rows were swapped. If the
+// matrix is singular, as reported by IsSingular, this is 0.
+func (lu *LU) Det() float32 {
+	if lu.singular {
+		return 0
+	}
+	det := lu.sign
+	for i := 0; i < lu.lu.m; i++ {
+		det *= lu.lu.At(i, i)
+	}
+
+	return det
+}
+
+// Solve solves the linear system Ax = b for x, where A is the decomposed
+// matrix, and stores the result in dst, which is Resized as necessary. Dst may
+// be b.
+//
+// If the matrix is singular, this returns a SingularMatrixError. If b is nil
+// or its size does not match the matrix, this returns a DimensionMismatchError.
+func (lu *LU) Solve(dst, b *VecN) (*VecN, error) {
+	n := lu.lu.m
+	if b == nil || len(b.vec) != n {
+		return nil, DimensionMismatchError{}
+	}
+	if lu.singular {
+		return nil, SingularMatrixError{}
+	}
+
+	x := NewVecN(n)
+	defer x.destroy()
+
+	for i, p := range lu.pivots {
+		x.vec[i] = b.vec[p]
+	}
+	lu.solveInPlace(x.vec)
+
+	dst = dst.Resize(n)
+	copy(dst.vec, x.vec)
+
+	return dst, nil
+}
+
+// solveInPlace performs forward and back substitution on x, which must
+// already be permuted.
+func (lu *LU) solveInPlace(x []float32) {
+	a, n := lu.lu, lu.lu.m
+
+	// Ly = Pb, L has a unit diagonal
+	for i := 1; i < n; i++ {
+		for k := 0; k < i; k++ {
+			x[i] -= a.At(i, k) * x[k]
+		}
+	}
+
+	// Ux = y
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			x[i] -= a.At(i, k) * x[k]
+		}
+		x[i] /= a.At(i, i)
+	}
+}
+
+// Inv stores the inverse of the decomposed matrix in dst, which is Reshaped as
+// necessary, and returns it. The inverse is found by solving for every column
+// of the identity matrix.
+//
+// If the matrix is singular, this returns a SingularMatrixError.
+func (lu *LU) Inv(dst *MatMxN) (*MatMxN, error) {
+	if lu.singular {
+		return nil, SingularMatrixError{}
+	}
+
+	n := lu.lu.m
+	dst = dst.Reshape(n, n)
+
+	for j := 0; j < n; j++ {
+		col := dst.dat[j*n : (j+1)*n]
+		for i, p := range lu.pivots {
+			if p == j {
+				col[i] = 1
+			} else {
+				col[i] = 0
+			}
+		}
+		lu.solveInPlace(col)
+	}
+
+	return dst, nil
+}
+
+// destroy returns the memory backing the decomposition to the memory pool.
+func (lu *LU) destroy() {
+	lu.lu.destroy()
+	lu.lu = nil
+}
+
+// Det returns the determinant of a square matrix, computed through its LU
+// decomposition. If the matrix is nil or not square, the result will be NaN,
+// and if it is singular, 0.
+//
+// The memory used during the computation is taken from, and returned to, the
+// memory pool.
+func (mat *MatMxN) Det() float32 {
+	var lu LU
+	if _, err := mat.LU(&lu); err != nil {
+		return float32(math.NaN())
+	}
+	defer lu.destroy()
+
+	return lu.Det()
+}
+
+// Inv computes the inverse of a square matrix through its LU decomposition
+// and stores it in dst, which is Reshaped as necessary. Dst may be mat. The
+// value returned is dst.
+//
+// If mat is nil, not square, or singular, this returns a NilMatrixError,
+// RectangularMatrixError or SingularMatrixError respectively.
+func (mat *MatMxN) Inv(dst *MatMxN) (*MatMxN, error) {
+	var lu LU
+	if _, err := mat.LU(&lu); err != nil {
+		return nil, err
+	}
+	defer lu.destroy()
+
+	return lu.Inv(dst)
+}
+
+// Solve solves the linear system mat*x = b for x through the LU decomposition
+// of mat, and stores x in dst, which is Resized as necessary. Dst may be b.
+//
+// If mat is nil, not square, or singular, this returns a NilMatrixError,
+// RectangularMatrixError or SingularMatrixError respectively. If b does not
+// match the size of mat, a DimensionMismatchError is returned. To solve the
+// same system for several vectors b, compute the LU decomposition once and
+// use LU.Solve.
+func (mat *MatMxN) Solve(dst, b *VecN) (*VecN, error) {
+	var lu LU
+	if _, err := mat.LU(&lu); err != nil {
+		return nil, err
+	}
+	defer lu.destroy()
+
+	return lu.Solve(dst, b)
+}
@@ -0,0 +1,207 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMxNDet(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20; i++ {
+		var m Mat4
+		for j := range m {
+			m[j] = r.Float32()*10 - 5
+		}
+
+		mn := NewMatrixFromData(m[:], 4, 4)
+		if det, correct := mn.Det(), m.Det(); !FloatEqualThreshold(det, correct, 1e-3) {
+			t.Errorf("Det of %v is %v, expected %v", m, det, correct)
+		}
+	}
+
+	m := NewMatrixFromData([]float32{
+		2, 0, 0, 0, 0,
+		1, 3, 0, 0, 0,
+		4, 1, 1, 0, 0,
+		0, 5, 2, 4, 0,
+		3, 2, 1, 7, 0.5,
+	}, 5, 5)
+	if det := m.Det(); !FloatEqualThreshold(det, 12, 1e-4) {
+		t.Errorf("Det of triangular matrix is %v, expected 12", det)
+	}
+
+	// Requires a row swap
+	swap := NewMatrixFromData([]float32{0, 1, 1, 0}, 2, 2)
+	if det := swap.Det(); !FloatEqual(det, -1) {
+		t.Errorf("Det of permutation matrix is %v, expected -1", det)
+	}
+}
+
+func TestMxNDetErrors(t *testing.T) {
+	var nilMat *MatMxN
+	if det := nilMat.Det(); det == det {
+		t.Errorf("Det of nil matrix is %v, expected NaN", det)
+	}
+	if det := NewMatrix(2, 3).Det(); det == det {
+		t.Errorf("Det of rectangular matrix is %v, expected NaN", det)
+	}
+	if det := NewMatrixFromData([]float32{1, 2, 2, 4}, 2, 2).Det(); det != 0 {
+		t.Errorf("Det of singular matrix is %v, expected 0", det)
+	}
+}
+
+func TestMxNInv(t *testing.T) {
+	m := HomogRotate3DX(DegToRad(30)).Mul4(Translate3D(1, 2, 3)).Mul4(Scale3D(2, 0.5, 4))
+	mn := NewMatrixFromData(m[:], 4, 4)
+
+	inv, err := mn.Inv(nil)
+	if err != nil {
+		t.Fatalf("Inv returned error: %v", err)
+	}
+
+	correct := m.Inv()
+	if !inv.ApproxEqualThreshold(NewMatrixFromData(correct[:], 4, 4), 1e-4) {
+		t.Errorf("Inv of %v is %v, expected %v", m, inv, correct)
+	}
+
+	// In place
+	if _, err := mn.Inv(mn); err != nil {
+		t.Fatalf("Inv returned error: %v", err)
+	}
+	if !mn.ApproxEqualThreshold(inv, 1e-6) {
+		t.Errorf("Inv in place gives %v, expected %v", mn, inv)
+	}
+
+	big := NewMatrixFromData([]float32{
+		4, 1, 0, 0, 2, 1,
+		1, 5, 1, 0, 0, 0,
+		0, 1, 6, 1, 0, 3,
+		0, 0, 1, 7, 1, 0,
+		2, 0, 0, 1, 8, 1,
+		0, 2, 0, 0, 1, 9,
+	}, 6, 6)
+	bigInv, err := big.Inv(nil)
+	if err != nil {
+		t.Fatalf("Inv returned error: %v", err)
+	}
+	ident := IdentN(nil, 6)
+	near := func(a, b float32) bool { return Abs(a-b) < 1e-5 }
+	if prod := big.MulMxN(nil, bigInv); !prod.ApproxEqualFunc(ident, near) {
+		t.Errorf("Product of matrix and its inverse is %v, expected identity", prod)
+	}
+}
+
+func TestMxNInvErrors(t *testing.T) {
+	var nilMat *MatMxN
+	if _, err := nilMat.Inv(nil); err != (NilMatrixError{}) {
+		t.Errorf("Inv of nil matrix returned %v, expected NilMatrixError", err)
+	}
+	if _, err := NewMatrix(2, 3).Inv(nil); err != (RectangularMatrixError{}) {
+		t.Errorf("Inv of rectangular matrix returned %v, expected RectangularMatrixError", err)
+	}
+	singular := NewMatrixFromData([]float32{1, 2, 3, 2, 4, 6, 0, 1, 1}, 3, 3)
+	if _, err := singular.Inv(nil); err != (SingularMatrixError{}) {
+		t.Errorf("Inv of singular matrix returned %v, expected SingularMatrixError", err)
+	}
+
+	// Rank deficient, but the last pivot rounds to a tiny value rather than 0
+	rounded := NewMatrixFromData([]float32{1, 4, 7, 2, 5, 8, 3, 6, 9}, 3, 3)
+	if _, err := rounded.Inv(nil); err != (SingularMatrixError{}) {
+		t.Errorf("Inv of rank deficient matrix returned %v, expected SingularMatrixError", err)
+	}
+	if det := rounded.Det(); det != 0 {
+		t.Errorf("Det of rank deficient matrix is %v, expected 0", det)
+	}
+	if _, err := rounded.Mul(nil, 10).Solve(nil, NewVecN(3)); err != (SingularMatrixError{}) {
+		t.Errorf("Solve with scaled rank deficient matrix returned %v, expected SingularMatrixError", err)
+	}
+}
+
+func TestMxNSolve(t *testing.T) {
+	m := NewMatrixFromData([]float32{
+		0, 1, 2,
+		1, 0, 1,
+		3, 2, 0,
+	}, 3, 3)
+	x := NewVecNFromData([]float32{1, -2, 3})
+	b := m.MulNx1(nil, x)
+
+	result, err := m.Solve(nil, b)
+	if err != nil {
+		t.Fatalf("Solve returned error: %v", err)
+	}
+	if !result.ApproxEqualThreshold(x, 1e-5) {
+		t.Errorf("Solve gives %v, expected %v", result.Raw(), x.Raw())
+	}
+
+	if _, err := m.Solve(b, b); err != nil || !b.ApproxEqualThreshold(x, 1e-5) {
+		t.Errorf("Solve in place gives %v (err %v), expected %v", b.Raw(), err, x.Raw())
+	}
+
+	if _, err := m.Solve(nil, NewVecN(4)); err != (DimensionMismatchError{}) {
+		t.Errorf("Solve with wrong sized vector returned %v, expected DimensionMismatchError", err)
+	}
+}
+
+func TestLUFactors(t *testing.T) {
+	m := NewMatrixFromData([]float32{
+		1, 4, 7,
+		2, 5, 8,
+		3, 6, 10,
+	}, 3, 3)
+
+	lu, err := m.LU(nil)
+	if err != nil {
+		t.Fatalf("LU returned error: %v", err)
+	}
+	if lu.IsSingular() {
+		t.Errorf("LU reports non-singular matrix as singular")
+	}
+
+	l, u := lu.L(nil), lu.U(nil)
+	prod := l.MulMxN(nil, u)
+
+	// Undo the permutation on the product
+	permuted := NewMatrix(3, 3)
+	for i, p := range lu.Pivots() {
+		for j := 0; j < 3; j++ {
+			permuted.Set(p, j, prod.At(i, j))
+		}
+	}
+
+	if !permuted.ApproxEqualThreshold(m, 1e-5) {
+		t.Errorf("P^-1 * L * U is %v, expected %v", permuted, m)
+	}
+
+	for i := 0; i < 3; i++ {
+		if l.At(i, i) != 1 {
+			t.Errorf("L does not have a unit diagonal: %v", l)
+		}
+		for j := i + 1; j < 3; j++ {
+			if l.At(i, j) != 0 || u.At(j, i) != 0 {
+				t.Errorf("L or U are not triangular: %v %v", l, u)
+			}
+		}
+	}
+
+	// Reuse of the decomposition
+	lu2, err := NewMatrixFromData([]float32{0, 1, 1, 0}, 2, 2).LU(lu)
+	if err != nil || lu2 != lu || lu.Size() != 2 || !FloatEqual(lu.Det(), -1) {
+		t.Errorf("Reusing LU decomposition failed: %v, det %v", err, lu.Det())
+	}
+}
+
+func BenchmarkMxNInv(b *testing.B) {
+	m := Translate3D(1, 2, 3).Mul4(HomogRotate3DY(1))
+	mn := NewMatrixFromData(m[:], 4, 4)
+	dst := NewMatrix(4, 4)
+
+	for i := 0; i < b.N; i++ {
+		mn.Inv(dst)
+	}
+}
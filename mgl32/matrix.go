@@ -2173,7 +2173,15 @@ func (m1 Mat4) Mul(c float32) Mat4 {
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
 // Mul4x2 will result in a Mat4x2.
 func (m1 Mat4) Mul4x1(m2 Vec4) Vec4 {
-	return Vec4{
+	var r Vec4
+	mat4Mul4x1(&r, &m1, &m2)
+	return r
+}
+
+// mat4Mul4x1Generic is the pure Go implementation of Mat4.Mul4x1, storing
+// m1*m2 in dst.
+func mat4Mul4x1Generic(dst *Vec4, m1 *Mat4, m2 *Vec4) {
+	*dst = Vec4{
 		m1[0]*m2[0] + m1[4]*m2[1] + m1[8]*m2[2] + m1[12]*m2[3],
 		m1[1]*m2[0] + m1[5]*m2[1] + m1[9]*m2[2] + m1[13]*m2[3],
 		m1[2]*m2[0] + m1[6]*m2[1] + m1[10]*m2[2] + m1[14]*m2[3],
@@ -2224,7 +2232,15 @@ func (m1 Mat4) Mul4x3(m2 Mat4x3) Mat4x3 {
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
 // Mul4x2 will result in a Mat4x2.
 func (m1 Mat4) Mul4(m2 Mat4) Mat4 {
-	return Mat4{
+	var r Mat4
+	mat4Mul4(&r, &m1, &m2)
+	return r
+}
+
+// mat4Mul4Generic is the pure Go implementation of Mat4.Mul4, storing
+// m1*m2 in dst.
+func mat4Mul4Generic(dst *Mat4, m1 *Mat4, m2 *Mat4) {
+	*dst = Mat4{
 		m1[0]*m2[0] + m1[4]*m2[1] + m1[8]*m2[2] + m1[12]*m2[3],
 		m1[1]*m2[0] + m1[5]*m2[1] + m1[9]*m2[2] + m1[13]*m2[3],
 		m1[2]*m2[0] + m1[6]*m2[1] + m1[10]*m2[2] + m1[14]*m2[3],
@@ -2276,9 +2292,20 @@ func (m Mat4) Det() float32 {
 // entirely plausible to get a false positive or negative.
 // In the future, an alternate function may be written which takes in a pre-computed determinant.
 func (m Mat4) Inv() Mat4 {
+	var r Mat4
+	if det := mat4Inv(&r, &m); FloatEqual(det, float32(0.0)) {
+		return Mat4{}
+	}
+	return r
+}
+
+// mat4InvGeneric is the pure Go implementation of Mat4.Inv. It stores the
+// inverse of m in dst and returns the determinant of m. If the determinant is
+// zero, the contents of dst are undefined.
+func mat4InvGeneric(dst, m *Mat4) float32 {
 	det := m.Det()
 	if FloatEqual(det, float32(0.0)) {
-		return Mat4{}
+		return det
 	}
 
 	retMat := Mat4{
@@ -2300,7 +2327,8 @@ func (m Mat4) Inv() Mat4 {
 		-m[2]*m[5]*m[8] + m[1]*m[6]*m[8] + m[2]*m[4]*m[9] - m[0]*m[6]*m[9] - m[1]*m[4]*m[10] + m[0]*m[5]*m[10],
 	}
 
-	return retMat.Mul(1 / det)
+	*dst = retMat.Mul(1 / det)
+	return det
 }
 
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
@@ -0,0 +1,350 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// OBB is an oriented bounding box in 3D space, described by its center, its
+// local axes as the columns of the rotation matrix Axes, and its half-extents
+// along each of those axes.
+//
+// The axes are assumed to be orthonormal. A box with a negative element in
+// HalfExtents is considered empty.
+type OBB struct {
+	Center      Vec3
+	Axes        Mat3
+	HalfExtents Vec3
+}
+
+// OBBFromAABB returns the oriented box with the same extent as the
+// axis-aligned box b.
+func OBBFromAABB(b AABB3) OBB {
+	return OBB{b.Center(), Ident3(), b.HalfExtents()}
+}
+
+// OBBFromPoints fits an oriented box around points with principal component
+// analysis. The axes of the box are the eigenvectors of the covariance matrix
+// of the points, which line up with the directions in which the points are
+// spread out the most, and its extents are the smallest ones along those axes
+// that contain all of the points.
+//
+// This is fast and usually gives a tight box, but not necessarily the
+// smallest possible one: for instance, points spread evenly over the surface
+// of a cube give a covariance matrix that doesn't favor any direction. If
+// points is empty, the result is an empty box at the origin.
+func OBBFromPoints(points []Vec3) OBB {
+	if len(points) == 0 {
+		return OBB{Axes: Ident3(), HalfExtents: Vec3{-1, -1, -1}}
+	}
+
+	var mean Vec3
+	for _, p := range points {
+		mean = mean.Add(p)
+	}
+	mean = mean.Mul(1 / float32(len(points)))
+
+	var cov Mat3
+	for _, p := range points {
+		d := p.Sub(mean)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				cov[j*3+i] += d[i] * d[j]
+			}
+		}
+	}
+	_, axes, _ := cov.Mul(1 / float32(len(points))).SymEigen(JacobiOptions{})
+
+	// Find the extent of the points along each axis, relative to the mean
+	min, max := Vec3{InfPos, InfPos, InfPos}, Vec3{InfNeg, InfNeg, InfNeg}
+	for _, p := range points {
+		local := axes.Transpose().Mul3x1(p.Sub(mean))
+		for i := range local {
+			SetMin(&min[i], &local[i])
+			SetMax(&max[i], &local[i])
+		}
+	}
+
+	return OBB{
+		Center:      mean.Add(axes.Mul3x1(min.Add(max).Mul(0.5))),
+		Axes:        axes,
+		HalfExtents: max.Sub(min).Mul(0.5),
+	}
+}
+
+// OBBFromMat4 returns the box that the cube from -1 to 1 on every axis is
+// transformed into by m, the inverse of Mat4. The upper 3x3 part of m must be
+// a rotation and a scale, without shearing, and its bottom row [0 0 0 1].
+func OBBFromMat4(m Mat4) OBB {
+	b := OBB{Center: Vec3{m[12], m[13], m[14]}}
+	for i := 0; i < 3; i++ {
+		col := m.Col(i).Vec3()
+		b.HalfExtents[i] = col.Len()
+		if b.HalfExtents[i] != 0 {
+			col = col.Mul(1 / b.HalfExtents[i])
+		}
+		b.Axes.SetCol(i, col)
+	}
+
+	// Fill in the axes of flat boxes, and flip mirrored ones, which contain
+	// the same points, to keep the axes a rotation
+	if b.Axes.Col(0) == (Vec3{}) {
+		b.Axes.SetCol(0, perpendicular(b.Axes.Col(1).Add(b.Axes.Col(2))))
+	}
+	if b.Axes.Col(1) == (Vec3{}) {
+		b.Axes.SetCol(1, b.Axes.Col(2).Cross(b.Axes.Col(0)))
+		if b.Axes.Col(1) == (Vec3{}) {
+			b.Axes.SetCol(1, perpendicular(b.Axes.Col(0)))
+		}
+	}
+	if b.Axes.Col(2) == (Vec3{}) || b.Axes.Det() < 0 {
+		b.Axes.SetCol(2, b.Axes.Col(0).Cross(b.Axes.Col(1)))
+	}
+
+	return b
+}
+
+// Mat4 returns the matrix that transforms the cube from -1 to 1 on every axis
+// into the box, which can be used to draw it or to transform points from the
+// local space of the box, where its extent is the range [-1,1], to world
+// space.
+func (b OBB) Mat4() Mat4 {
+	m := b.Axes.Mat4()
+	for i := 0; i < 3; i++ {
+		m.SetCol(i, m.Col(i).Mul(b.HalfExtents[i]))
+	}
+	m.SetCol(3, b.Center.Vec4(1))
+	return m
+}
+
+// IsEmpty returns whether the box contains no points, that is, if any element
+// of HalfExtents is negative.
+func (b OBB) IsEmpty() bool {
+	return b.HalfExtents[0] < 0 || b.HalfExtents[1] < 0 || b.HalfExtents[2] < 0
+}
+
+// Volume returns the volume of the box, or 0 if it's empty.
+func (b OBB) Volume() float32 {
+	if b.IsEmpty() {
+		return 0
+	}
+
+	return 8 * b.HalfExtents[0] * b.HalfExtents[1] * b.HalfExtents[2]
+}
+
+// Corners returns the eight corners of the box. Corner i is at the positive
+// end of axis j where bit j of i is set, and at its negative end otherwise.
+func (b OBB) Corners() [8]Vec3 {
+	var corners [8]Vec3
+	for i := range corners {
+		p := b.Center
+		for j := 0; j < 3; j++ {
+			e := b.Axes.Col(j).Mul(b.HalfExtents[j])
+			if i&(1<<uint(j)) != 0 {
+				p = p.Add(e)
+			} else {
+				p = p.Sub(e)
+			}
+		}
+		corners[i] = p
+	}
+
+	return corners
+}
+
+// AABB returns the tightest axis-aligned box containing b.
+func (b OBB) AABB() AABB3 {
+	if b.IsEmpty() {
+		return EmptyAABB3()
+	}
+
+	var extent Vec3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			extent[i] += Abs(b.Axes.At(i, j)) * b.HalfExtents[j]
+		}
+	}
+
+	return AABB3{b.Center.Sub(extent), b.Center.Add(extent)}
+}
+
+// ContainsPoint returns whether p is inside the box or on its boundary.
+func (b OBB) ContainsPoint(p Vec3) bool {
+	d := p.Sub(b.Center)
+	for i := 0; i < 3; i++ {
+		if Abs(d.Dot(b.Axes.Col(i))) > b.HalfExtents[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ClosestPoint returns the point in (or on) the box that is closest to p. If
+// p is inside the box, it is returned, up to rounding errors.
+func (b OBB) ClosestPoint(p Vec3) Vec3 {
+	d := p.Sub(b.Center)
+	q := b.Center
+	for i := 0; i < 3; i++ {
+		axis := b.Axes.Col(i)
+		q = q.Add(axis.Mul(Clamp(d.Dot(axis), -b.HalfExtents[i], b.HalfExtents[i])))
+	}
+
+	return q
+}
+
+// DistSqr returns the square of the distance between p and the closest point
+// of the box. Points inside the box have a distance of 0.
+func (b OBB) DistSqr(p Vec3) float32 {
+	d := p.Sub(b.Center)
+	var dist float32
+	for i := 0; i < 3; i++ {
+		x := Abs(d.Dot(b.Axes.Col(i)))
+		if x > b.HalfExtents[i] {
+			dist += (x - b.HalfExtents[i]) * (x - b.HalfExtents[i])
+		}
+	}
+
+	return dist
+}
+
+// Support returns the corner of the box that is farthest in the direction
+// dir, which makes OBB a Shape.
+func (b OBB) Support(dir Vec3) Vec3 {
+	p := b.Center
+	for i := 0; i < 3; i++ {
+		axis := b.Axes.Col(i)
+		if dir.Dot(axis) > 0 {
+			p = p.Add(axis.Mul(b.HalfExtents[i]))
+		} else {
+			p = p.Sub(axis.Mul(b.HalfExtents[i]))
+		}
+	}
+
+	return p
+}
+
+// Intersects returns whether the two boxes overlap or touch, with the
+// separating axis test. The boxes are disjoint if and only if their
+// projections onto one of the 15 axes given by the 3 axes of each box and the
+// cross products of every pair of them don't overlap.
+//
+// This is the formulation of Gottschalk, Lin and Manocha ("OBBTree: A
+// Hierarchical Structure for Rapid Interference Detection", 1996), which
+// works in the frame of b1.
+func (b1 OBB) Intersects(b2 OBB) bool {
+	if b1.IsEmpty() || b2.IsEmpty() {
+		return false
+	}
+	a, b := b1.HalfExtents, b2.HalfExtents
+
+	// The rotation from b2's frame to b1's, and the translation between their
+	// centers in b1's frame. The epsilon keeps the cross products of nearly
+	// parallel axes, which are close to the zero vector, from separating the
+	// boxes because of rounding errors.
+	var r, absR [3][3]float32
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = b1.Axes.Col(i).Dot(b2.Axes.Col(j))
+			absR[i][j] = Abs(r[i][j]) + machineEpsilon*4
+		}
+	}
+	t := b1.Axes.Transpose().Mul3x1(b2.Center.Sub(b1.Center))
+
+	// The axes of b1
+	for i := 0; i < 3; i++ {
+		if Abs(t[i]) > a[i]+b[0]*absR[i][0]+b[1]*absR[i][1]+b[2]*absR[i][2] {
+			return false
+		}
+	}
+
+	// The axes of b2
+	for j := 0; j < 3; j++ {
+		if Abs(t[0]*r[0][j]+t[1]*r[1][j]+t[2]*r[2][j]) > a[0]*absR[0][j]+a[1]*absR[1][j]+a[2]*absR[2][j]+b[j] {
+			return false
+		}
+	}
+
+	// The cross products of axis i of b1 and axis j of b2
+	for i := 0; i < 3; i++ {
+		i1, i2 := (i+1)%3, (i+2)%3
+		for j := 0; j < 3; j++ {
+			j1, j2 := (j+1)%3, (j+2)%3
+			ra := a[i1]*absR[i2][j] + a[i2]*absR[i1][j]
+			rb := b[j1]*absR[i][j2] + b[j2]*absR[i][j1]
+			if Abs(t[i2]*r[i1][j]-t[i1]*r[i2][j]) > ra+rb {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// IntersectsTriangle returns whether the box overlaps or touches the triangle
+// with the corners v0, v1 and v2, with the separating axis test of
+// Akenine-Möller ("Fast 3D Triangle-Box Overlap Testing", 2001). The axes are
+// the 3 axes of the box, the normal of the triangle, and the cross products of
+// each axis of the box with each edge of the triangle.
+func (b OBB) IntersectsTriangle(v0, v1, v2 Vec3) bool {
+	if b.IsEmpty() {
+		return false
+	}
+	h := b.HalfExtents
+
+	// Work in the frame of the box, where it's centered on the origin
+	toLocal := b.Axes.Transpose()
+	v := [3]Vec3{
+		toLocal.Mul3x1(v0.Sub(b.Center)),
+		toLocal.Mul3x1(v1.Sub(b.Center)),
+		toLocal.Mul3x1(v2.Sub(b.Center)),
+	}
+
+	// separated returns whether the triangle and the box don't overlap when
+	// projected onto axis
+	separated := func(axis Vec3) bool {
+		p0, p1, p2 := axis.Dot(v[0]), axis.Dot(v[1]), axis.Dot(v[2])
+		r := h[0]*Abs(axis[0]) + h[1]*Abs(axis[1]) + h[2]*Abs(axis[2])
+		min, max := p0, p0
+		SetMin(&min, &p1)
+		SetMin(&min, &p2)
+		SetMax(&max, &p1)
+		SetMax(&max, &p2)
+		return min > r || max < -r
+	}
+
+	edges := [3]Vec3{v[1].Sub(v[0]), v[2].Sub(v[1]), v[0].Sub(v[2])}
+	axes := [3]Vec3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	for _, axis := range axes {
+		if separated(axis) {
+			return false
+		}
+	}
+	if separated(edges[0].Cross(edges[1])) {
+		return false
+	}
+	for _, axis := range axes {
+		for _, edge := range edges {
+			if separated(axis.Cross(edge)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// ApproxEqual returns whether the centers, axes and half-extents of the two
+// boxes are approximately equal, as if FloatEqual had been called on each
+// element. Boxes that contain the same points with their axes in a different
+// order or direction are not considered equal.
+func (b1 OBB) ApproxEqual(b2 OBB) bool {
+	return b1.Center.ApproxEqual(b2.Center) && b1.Axes.ApproxEqual(b2.Axes) && b1.HalfExtents.ApproxEqual(b2.HalfExtents)
+}
+
+// ApproxEqualThreshold returns whether the centers, axes and half-extents of
+// the two boxes are approximately equal with a given tolerance, as if
+// FloatEqualThreshold had been called on each element.
+func (b1 OBB) ApproxEqualThreshold(b2 OBB, epsilon float32) bool {
+	return b1.Center.ApproxEqualThreshold(b2.Center, epsilon) && b1.Axes.ApproxEqualThreshold(b2.Axes, epsilon) &&
+		b1.HalfExtents.ApproxEqualThreshold(b2.HalfExtents, epsilon)
+}
@@ -0,0 +1,212 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func randRotation(r *rand.Rand) Mat3 {
+	axis := Vec3{r.Float32()*2 - 1, r.Float32()*2 - 1, r.Float32()*2 - 1}.Normalize()
+	return QuatRotate(r.Float32()*2*math.Pi, axis).Mat4().Mat3()
+}
+
+func randOBB(r *rand.Rand) OBB {
+	return OBB{
+		Center:      randVec3s(r, 1)[0].Mul(3),
+		Axes:        randRotation(r),
+		HalfExtents: Vec3{0.1 + r.Float32(), 0.1 + r.Float32(), 0.1 + r.Float32()},
+	}
+}
+
+// localPoint returns the point at the coordinates local of the box, where
+// the box spans [-1,1] on each axis.
+func (b OBB) localPoint(local Vec3) Vec3 {
+	return b.Mat4().Mul4x1(local.Vec4(1)).Vec3()
+}
+
+func TestOBBFromPoints(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20; n++ {
+		box := randOBB(r)
+		box.HalfExtents = Vec3{3, 1, 0.3}
+
+		// For a symmetric grid of points, the principal axes are exactly the
+		// axes of the box
+		var points []Vec3
+		for x := -2; x <= 2; x++ {
+			for y := -2; y <= 2; y++ {
+				for z := -2; z <= 2; z++ {
+					points = append(points, box.localPoint(Vec3{float32(x), float32(y), float32(z)}.Mul(0.5)))
+				}
+			}
+		}
+		if fit := OBBFromPoints(points); !FloatEqualThreshold(fit.Volume(), box.Volume(), 1e-3) {
+			t.Errorf("OBBFromPoints of a grid has the volume %v, expected %v", fit.Volume(), box.Volume())
+		}
+
+		for _, p := range randVec3s(r, 50) {
+			points = append(points, box.localPoint(p))
+		}
+		fit := OBBFromPoints(points)
+		if !fit.Axes.Mul3(fit.Axes.Transpose()).ApproxFuncEqual(Ident3(), within(1e-4)) || !FloatEqualThreshold(fit.Axes.Det(), 1, 1e-4) {
+			t.Fatalf("OBBFromPoints axes %v aren't a rotation", fit.Axes)
+		}
+		for _, p := range points {
+			if fit.DistSqr(p) > 1e-8 {
+				t.Fatalf("OBBFromPoints box %v doesn't contain %v", fit, p)
+			}
+		}
+	}
+
+	if b := OBBFromPoints(nil); !b.IsEmpty() || b.Volume() != 0 {
+		t.Errorf("OBBFromPoints(nil) = %v, expected an empty box", b)
+	}
+}
+
+func TestOBBMat4(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 20; i++ {
+		b := randOBB(r)
+		if b2 := OBBFromMat4(b.Mat4()); !b2.ApproxEqualThreshold(b, 1e-4) {
+			t.Errorf("OBBFromMat4(%v.Mat4()) = %v", b, b2)
+		}
+	}
+
+	// A mirrored, flat box
+	b := OBBFromMat4(Translate3D(1, 2, 3).Mul4(Scale3D(-2, 0, 1)))
+	expected := OBB{Vec3{1, 2, 3}, Ident3(), Vec3{2, 0, 1}}
+	if !FloatEqualThreshold(b.Axes.Det(), 1, 1e-5) || !b.AABB().ApproxEqual(expected.AABB()) {
+		t.Errorf("OBBFromMat4 of a mirrored flat box = %v, expected %v", b, expected)
+	}
+
+	box := OBBFromAABB(AABB3{Vec3{-1, 0, 2}, Vec3{3, 1, 4}})
+	if !box.AABB().ApproxEqual(AABB3{Vec3{-1, 0, 2}, Vec3{3, 1, 4}}) {
+		t.Errorf("OBBFromAABB(b).AABB() = %v", box.AABB())
+	}
+	c := box.Corners()
+	corners := AABB3FromPoints(c[:])
+	if !corners.ApproxEqual(box.AABB()) {
+		t.Errorf("Corners of %v span %v, expected %v", box, corners, box.AABB())
+	}
+}
+
+func TestOBBPoints(t *testing.T) {
+	t.Parallel()
+
+	// A box rotated by 45 degrees around Z, spanning [-1,1] on its axes
+	b := OBB{Vec3{1, 0, 0}, HomogRotate3DZ(math.Pi / 4).Mat3(), Vec3{1, 1, 1}}
+	tests := []struct {
+		Point, Closest Vec3
+	}{
+		{Vec3{1, 0, 0}, Vec3{1, 0, 0}},
+		{Vec3{2.4, 0, 0.5}, Vec3{2.4, 0, 0.5}},
+		{Vec3{3, 0, 0}, Vec3{1 + math.Sqrt2, 0, 0}},
+		{Vec3{2, 1, 0}, Vec3{1 + math.Sqrt2/2, math.Sqrt2 / 2, 0}},
+		{Vec3{1, 0, -3}, Vec3{1, 0, -1}},
+	}
+	for _, test := range tests {
+		if c := b.ClosestPoint(test.Point); !c.ApproxFuncEqual(test.Closest, within(1e-5)) {
+			t.Errorf("ClosestPoint(%v) = %v, expected %v", test.Point, c, test.Closest)
+		}
+		contains := test.Point.ApproxFuncEqual(test.Closest, within(1e-5))
+		if b.ContainsPoint(test.Point) != contains {
+			t.Errorf("ContainsPoint(%v) = %v, expected %v", test.Point, !contains, contains)
+		}
+		dist := test.Point.Sub(test.Closest).LenSqr()
+		if d := b.DistSqr(test.Point); !FloatEqualThreshold(d, dist, 1e-4) {
+			t.Errorf("DistSqr(%v) = %v, expected %v", test.Point, d, dist)
+		}
+	}
+}
+
+// crossingRod returns a thin box along (0, 1, 1) that passes the X axis at the
+// distance d.
+func crossingRod(d float32) OBB {
+	long := Vec3{0, 1, 1}.Normalize()
+	side := QuatRotate(math.Pi/6, long).Rotate(Vec3{0, 1, -1}.Normalize())
+	return OBB{Vec3{0, -d, d}, Mat3FromCols(long, side, long.Cross(side)), Vec3{5, 0.1, 0.1}}
+}
+
+func TestOBBIntersects(t *testing.T) {
+	t.Parallel()
+
+	unit := OBB{Axes: Ident3(), HalfExtents: Vec3{1, 1, 1}}
+	rotated := func(center Vec3) OBB {
+		return OBB{center, HomogRotate3DZ(math.Pi / 4).Mat3(), Vec3{1, 1, 1}}
+	}
+	tests := []struct {
+		B1, B2 OBB
+		Result bool
+	}{
+		{unit, unit, true},
+		{unit, OBB{Vec3{2, 0, 0}, Ident3(), Vec3{1, 1, 1}}, true},
+		{unit, OBB{Vec3{2.01, 0, 0}, Ident3(), Vec3{1, 1, 1}}, false},
+		{unit, rotated(Vec3{2.3, 0, 0}), true},
+		{unit, rotated(Vec3{2.5, 0, 0}), false},
+		// Two crossing rods, separated only by the cross product of their
+		// long axes
+		{OBB{Vec3{}, Ident3(), Vec3{5, 0.1, 0.1}}, crossingRod(0.3), false},
+		{OBB{Vec3{}, Ident3(), Vec3{5, 0.1, 0.1}}, crossingRod(0.15), true},
+	}
+	for _, test := range tests {
+		if test.B1.Intersects(test.B2) != test.Result || test.B2.Intersects(test.B1) != test.Result {
+			t.Errorf("%v.Intersects(%v) != %v", test.B1, test.B2, test.Result)
+		}
+	}
+
+	// Compare against points sampled in the boxes
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 200; i++ {
+		b1, b2 := randOBB(r), randOBB(r)
+		overlap := false
+		for j := 0; j < 500 && !overlap; j++ {
+			overlap = b1.ContainsPoint(b2.localPoint(randVec3s(r, 1)[0]))
+		}
+		if overlap && !b1.Intersects(b2) {
+			t.Errorf("%v.Intersects(%v) = false, but they share a point", b1, b2)
+		}
+		if !b1.AABB().Intersects(b2.AABB()) && b1.Intersects(b2) {
+			t.Errorf("%v.Intersects(%v) = true, but their AABBs are disjoint", b1, b2)
+		}
+	}
+}
+
+func TestOBBIntersectsTriangle(t *testing.T) {
+	t.Parallel()
+
+	unit := OBB{Axes: Ident3(), HalfExtents: Vec3{1, 1, 1}}
+	b := OBB{Vec3{5, 0, 0}, HomogRotate3DY(0.3).Mat3(), Vec3{1, 2, 3}}
+	corner := func(d float32) [3]Vec3 {
+		return [3]Vec3{{d, 0, 0}, {0, d, 0}, {0, 0, d}}
+	}
+	tests := []struct {
+		Box    OBB
+		Tri    [3]Vec3
+		Result bool
+	}{
+		{unit, [3]Vec3{{0, 0, 0}, {0.5, 0, 0}, {0, 0.5, 0}}, true},
+		{unit, [3]Vec3{{-5, -5, 0}, {5, -5, 0}, {0, 5, 0}}, true},
+		{unit, [3]Vec3{{5, 5, 5}, {6, 5, 5}, {5, 6, 5}}, false},
+		{unit, corner(2.9), true},
+		{unit, corner(3.1), false},
+		// Separated only by the cross product of an edge and the Z axis
+		{unit, [3]Vec3{{2.2, 0, 0}, {0, 2.2, 0}, {11.1, 11.1, 0.5}}, false},
+		{unit, [3]Vec3{{1.8, 0, 0}, {0, 1.8, 0}, {11.1, 11.1, 0.5}}, true},
+		{b, [3]Vec3{{5, 0, 0}, {10, 0, 0}, {10, 1, 0}}, true},
+		{b, [3]Vec3{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}}, false},
+	}
+	for _, test := range tests {
+		if test.Box.IntersectsTriangle(test.Tri[0], test.Tri[1], test.Tri[2]) != test.Result {
+			t.Errorf("%v.IntersectsTriangle(%v) != %v", test.Box, test.Tri, test.Result)
+		}
+	}
+}
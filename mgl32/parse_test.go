@@ -0,0 +1,155 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseVec(t *testing.T) {
+	t.Parallel()
+
+	expected := Vec3{1, -2.5, 3e-3}
+	tests := []string{
+		"1 -2.5 0.003",
+		"1,-2.5,3e-3",
+		"  (1, -2.5, 0.003)\n",
+		"[1 -2.5 0.003]",
+		"{1;-2.5;0.003}",
+		"1\n-2.5\n0.003",
+		"((1, -2.5, 0.003))",
+		"mgl32.Vec3{1, -2.5, 0.003}",
+		fmt.Sprint(expected),
+		fmt.Sprintf("%#v", expected),
+	}
+	for _, test := range tests {
+		v, err := ParseVec3(test)
+		if err != nil {
+			t.Errorf("ParseVec3(%q): %v", test, err)
+		} else if v != expected {
+			t.Errorf("ParseVec3(%q) = %v, expected %v", test, v, expected)
+		}
+	}
+
+	if v, err := ParseVec2("NaN Inf"); err != nil || v[0] == v[0] || v[1] != InfPos {
+		t.Errorf("ParseVec2 of NaN and Inf got %v, %v", v, err)
+	}
+	if v, err := ParseVec4(fmt.Sprint(Vec4{1, 2, 3, 4})); err != nil || v != (Vec4{1, 2, 3, 4}) {
+		t.Errorf("ParseVec4 of the fmt format got %v, %v", v, err)
+	}
+}
+
+func TestParseMat(t *testing.T) {
+	t.Parallel()
+
+	// The rows are 1 2 3 and 4 5 6
+	expected := Mat2x3{1, 4, 2, 5, 3, 6}
+	tests := []string{
+		expected.String(),
+		"1 2 3\n4 5 6",
+		"1, 2, 3; 4, 5, 6",
+		"[[1, 2, 3], [4, 5, 6]]",
+		"[1 2 3]\n[4 5 6]",
+		"{(1 2 3) (4 5 6)}",
+		"1 4 2 5 3 6",
+		fmt.Sprint(expected),
+		fmt.Sprintf("%#v", expected),
+	}
+	for _, test := range tests {
+		m, err := ParseMat2x3(test)
+		if err != nil {
+			t.Errorf("ParseMat2x3(%q): %v", test, err)
+		} else if m != expected {
+			t.Errorf("ParseMat2x3(%q) = %v, expected %v", test, m, expected)
+		}
+	}
+
+	m4 := HomogRotate3D(0.7, Vec3{1, 2, 3}.Normalize()).Mul4(Translate3D(1, 2, 3))
+	if m, err := ParseMat4(m4.String()); err != nil || !m.ApproxEqualThreshold(m4, 1e-5) {
+		t.Errorf("ParseMat4(%q) = %v, %v, expected %v", m4.String(), m, err, m4)
+	}
+}
+
+func TestParseQuatVecNMatMxN(t *testing.T) {
+	t.Parallel()
+
+	q := Quat{1, Vec3{2, 3, 4}}
+	if got, err := ParseQuat(fmt.Sprint(q)); err != nil || got != q {
+		t.Errorf("ParseQuat(%q) = %v, %v", fmt.Sprint(q), got, err)
+	}
+
+	vn, err := ParseVecN("(1, 2, 3, 4, 5)")
+	if err != nil || !vn.ApproxEqual(NewVecNFromData([]float32{1, 2, 3, 4, 5})) {
+		t.Errorf("ParseVecN got %v, %v", vn, err)
+	}
+
+	expected := NewMatrixFromData([]float32{1, 4, 2, 5, 3, 6}, 2, 3)
+	for _, test := range []string{"1 2 3\n4 5 6", "[[1, 2, 3], [4, 5, 6]]", "2x3 1 4 2 5 3 6", "2x3\n1 2 3\n4 5 6"} {
+		mn, err := ParseMatMxN(test)
+		if err != nil {
+			t.Errorf("ParseMatMxN(%q): %v", test, err)
+		} else if !mn.ApproxEqual(expected) {
+			t.Errorf("ParseMatMxN(%q) = %v, expected %v", test, mn, expected)
+		}
+	}
+
+	text, _ := expected.MarshalText()
+	if mn, err := ParseMatMxN(string(text)); err != nil || !mn.ApproxEqual(expected) {
+		t.Errorf("ParseMatMxN(%q) = %v, %v", text, mn, err)
+	}
+
+	if mn, err := ParseMatMxN("[[1 2 3]]"); err != nil || mn.NumRows() != 1 || mn.NumCols() != 3 {
+		t.Errorf("ParseMatMxN of a single row got %v, %v", mn, err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	parse := map[string]func(string) error{
+		"Vec3":   func(s string) error { _, err := ParseVec3(s); return err },
+		"Mat2":   func(s string) error { _, err := ParseMat2(s); return err },
+		"Quat":   func(s string) error { _, err := ParseQuat(s); return err },
+		"MatMxN": func(s string) error { _, err := ParseMatMxN(s); return err },
+	}
+
+	tests := []struct {
+		Type         string
+		In           string
+		Line, Column int
+	}{
+		{"Vec3", "1 2", 1, 4},
+		{"Vec3", "1 2 3 4", 1, 7},
+		{"Vec3", "(1, x, 3)", 1, 5},
+		{"Vec3", "(1, 2, 3", 1, 9},
+		{"Vec3", "1, 2, 3)", 1, 8},
+		{"Vec3", "1 2\n3 4", 2, 3},
+		{"Mat2", "1 2\n3", 2, 1},
+		{"Mat2", "1 2\n3 4 5", 2, 5},
+		{"Mat2", "1 2\n3 4\n5 6", 3, 1},
+		{"Mat2", "[1 2] 3 4", 1, 7},
+		{"Mat2", "1 2 [3 4]", 1, 5},
+		{"Mat2", "1 2 3", 1, 6},
+		{"Quat", "1 0 0", 1, 6},
+		{"MatMxN", "1 2 3", 1, 1},
+		{"MatMxN", "1 2 3\n4 5", 2, 3},
+		{"MatMxN", "2x2 1 2 3", 1, 10},
+		{"Mat2", "[[1,2],[3,4],[]]", 1, 14},
+		{"MatMxN", "4294967296x4294967296", 1, 22},
+		{"MatMxN", "4294967296x4294967296 1 2", 1, 26},
+	}
+	for _, test := range tests {
+		err := parse[test.Type](test.In)
+		perr, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("Parse%s(%q): expected a *ParseError, got %v", test.Type, test.In, err)
+			continue
+		}
+		if perr.Type != test.Type || perr.Line != test.Line || perr.Column != test.Column {
+			t.Errorf("Parse%s(%q): got error %q at %d:%d, expected %d:%d", test.Type, test.In, perr, perr.Line, perr.Column, test.Line, test.Column)
+		}
+	}
+}
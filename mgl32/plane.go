@@ -0,0 +1,178 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// Plane is an infinite plane in 3D space, given as the set of all points p
+// such that Normal.Dot(p) + D == 0. Interpreted as a Vec4 {Normal, D}, this is
+// the same representation used for clipping planes in OpenGL.
+//
+// The plane divides space in two: points on the side Normal points towards
+// have a positive signed distance, points on the other side a negative one. If
+// Normal has unit length (see Normalize), -D is the distance of the plane from
+// the origin, and SignedDistance returns actual distances.
+type Plane struct {
+	Normal Vec3
+	D      float32
+}
+
+// PlaneFromNormal creates a plane with the given normal at the given
+// distance from the origin, measured along the normal. That is, the point
+// normal.Mul(distance) will be on the plane. The normal is normalized before
+// use.
+func PlaneFromNormal(normal Vec3, distance float32) Plane {
+	return Plane{normal.Normalize(), -distance}
+}
+
+// PlaneFromPointNormal creates the plane going through point with the given
+// normal. The normal is normalized before use.
+func PlaneFromPointNormal(point, normal Vec3) Plane {
+	normal = normal.Normalize()
+	return Plane{normal, -normal.Dot(point)}
+}
+
+// PlaneFromPoints creates the plane going through the three points of a
+// triangle. The normal of the plane follows the usual OpenGL convention: it
+// points to the side from which the triangle appears to be wound
+// counter-clockwise, and is equal to the normalized
+// p2.Sub(p1).Cross(p3.Sub(p1)).
+//
+// If the points are collinear, the result is undefined.
+func PlaneFromPoints(p1, p2, p3 Vec3) Plane {
+	return PlaneFromPointNormal(p1, p2.Sub(p1).Cross(p3.Sub(p1)))
+}
+
+// Normalize scales the plane such that its normal has unit length. The plane
+// itself (the set of points on it) is unchanged.
+func (p Plane) Normalize() Plane {
+	l := 1.0 / p.Normal.Len()
+	return Plane{p.Normal.Mul(l), p.D * l}
+}
+
+// SignedDistance returns Normal.Dot(point) + D. This is positive for points
+// on the side the normal points towards, negative for points on the other
+// side, and 0 for points on the plane. If the plane is normalized this is the
+// distance of the point from the plane.
+func (p Plane) SignedDistance(point Vec3) float32 {
+	return p.Normal.Dot(point) + p.D
+}
+
+// Vec4 returns the plane as the vector {Normal, D}.
+func (p Plane) Vec4() Vec4 {
+	return p.Normal.Vec4(p.D)
+}
+
+// Flip returns the same plane with the normal pointing the other way.
+func (p Plane) Flip() Plane {
+	return Plane{p.Normal.Mul(-1), -p.D}
+}
+
+// ProjectPoint returns the point on the plane closest to point, that is, the
+// orthogonal projection of point onto the plane.
+func (p Plane) ProjectPoint(point Vec3) Vec3 {
+	return point.Sub(p.Normal.Mul(p.SignedDistance(point) / p.Normal.LenSqr()))
+}
+
+// IntersectLine intersects the plane with the line going through p1 and p2.
+//
+// It returns the point of intersection, along with the parameter t such that
+// the point is equal to p1.Add(p2.Sub(p1).Mul(t)). Thus the segment between
+// p1 and p2 crosses the plane if t is in the range [0,1]. If the line is
+// parallel to the plane, ok is false.
+func (p Plane) IntersectLine(p1, p2 Vec3) (point Vec3, t float32, ok bool) {
+	dir := p2.Sub(p1)
+	denom := p.Normal.Dot(dir)
+	if Abs(denom) < Epsilon {
+		return Vec3{}, 0, false
+	}
+
+	t = -p.SignedDistance(p1) / denom
+	return p1.Add(dir.Mul(t)), t, true
+}
+
+// IntersectPlane returns the line along which two planes intersect, given as
+// a point on the line and the line's (normalized) direction. The point
+// returned is the point on the line closest to the origin. If the planes are
+// parallel, ok is false.
+func (p1 Plane) IntersectPlane(p2 Plane) (point, dir Vec3, ok bool) {
+	dir = p1.Normal.Cross(p2.Normal)
+	lenSqr := dir.LenSqr()
+	if lenSqr < Epsilon {
+		return Vec3{}, Vec3{}, false
+	}
+
+	// The point is a combination of the two normals lying on both planes
+	point = p1.Normal.Mul(p2.D).Sub(p2.Normal.Mul(p1.D)).Cross(dir).Mul(1 / lenSqr)
+	return point, dir.Mul(float32(1 / math.Sqrt(float64(lenSqr)))), true
+}
+
+// IntersectPlanes returns the single point where the three planes meet. If any
+// two of the planes are parallel, or all three planes share a line, there is
+// no such point and ok is false.
+func IntersectPlanes(p1, p2, p3 Plane) (point Vec3, ok bool) {
+	n23 := p2.Normal.Cross(p3.Normal)
+	det := p1.Normal.Dot(n23)
+	if Abs(det) < Epsilon {
+		return Vec3{}, false
+	}
+
+	point = n23.Mul(-p1.D).
+		Sub(p3.Normal.Cross(p1.Normal).Mul(p2.D)).
+		Sub(p1.Normal.Cross(p2.Normal).Mul(p3.D))
+
+	return point.Mul(1 / det), true
+}
+
+// Reflection returns the homogeneous matrix that mirrors points across the
+// plane. For a plane with unit normal n this is
+//
+//	[[ 1-2nx*nx,  -2nx*ny,  -2nx*nz, -2nx*d ]]
+//	[[  -2ny*nx, 1-2ny*ny,  -2ny*nz, -2ny*d ]]
+//	[[  -2nz*nx,  -2nz*ny, 1-2nz*nz, -2nz*d ]]
+//	[[        0,        0,        0,      1 ]]
+//
+// The plane does not need to be normalized.
+func (p Plane) Reflection() Mat4 {
+	p = p.Normalize()
+	x, y, z, d := p.Normal[0], p.Normal[1], p.Normal[2], p.D
+
+	return Mat4{
+		1 - 2*x*x, -2 * y * x, -2 * z * x, 0,
+		-2 * x * y, 1 - 2*y*y, -2 * z * y, 0,
+		-2 * x * z, -2 * y * z, 1 - 2*z*z, 0,
+		-2 * x * d, -2 * y * d, -2 * z * d, 1,
+	}
+}
+
+// Transform returns the plane transformed by the homogeneous matrix m, such
+// that for every point x on p, TransformCoordinate(x, m) is on the returned
+// plane.
+//
+// Like normals (see Mat4Normal), planes transform by the inverse transpose of
+// the matrix. Scaling in m will change the length of the normal, so call
+// Normalize on the result if you need it to have unit length. If m is not
+// invertible, the result is the zero plane.
+func (p Plane) Transform(m Mat4) Plane {
+	v := m.Inv().Transpose().Mul4x1(p.Vec4())
+	return Plane{v.Vec3(), v[3]}
+}
+
+// ApproxEqual returns whether the two planes have approximately equal
+// elements, as if FloatEqual had been called on each of them. Note that two
+// planes that are scaled versions of each other describe the same points, but
+// are not considered equal unless they're normalized.
+func (p1 Plane) ApproxEqual(p2 Plane) bool {
+	return p1.Normal.ApproxEqual(p2.Normal) && FloatEqual(p1.D, p2.D)
+}
+
+// ApproxEqualThreshold returns whether the two planes have approximately
+// equal elements with a given tolerance, as if FloatEqualThreshold had been
+// called on each of them.
+func (p1 Plane) ApproxEqualThreshold(p2 Plane, epsilon float32) bool {
+	return p1.Normal.ApproxEqualThreshold(p2.Normal, epsilon) && FloatEqualThreshold(p1.D, p2.D, epsilon)
+}
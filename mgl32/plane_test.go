@@ -0,0 +1,181 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPlaneConstructors(t *testing.T) {
+	t.Parallel()
+
+	expected := Plane{Vec3{0, 0, 1}, -2}
+
+	if p := PlaneFromNormal(Vec3{0, 0, 5}, 2); !p.ApproxEqual(expected) {
+		t.Errorf("PlaneFromNormal = %v, expected %v", p, expected)
+	}
+	if p := PlaneFromPointNormal(Vec3{3, -7, 2}, Vec3{0, 0, 2}); !p.ApproxEqual(expected) {
+		t.Errorf("PlaneFromPointNormal = %v, expected %v", p, expected)
+	}
+	if p := PlaneFromPoints(Vec3{0, 0, 2}, Vec3{1, 0, 2}, Vec3{0, 1, 2}); !p.ApproxEqual(expected) {
+		t.Errorf("PlaneFromPoints = %v, expected %v", p, expected)
+	}
+	if p := PlaneFromPoints(Vec3{0, 0, 2}, Vec3{0, 1, 2}, Vec3{1, 0, 2}); !p.ApproxEqual(expected.Flip()) {
+		t.Errorf("PlaneFromPoints with clockwise winding = %v, expected %v", p, expected.Flip())
+	}
+	if p := (Plane{Vec3{0, 0, 4}, -8}).Normalize(); !p.ApproxEqual(expected) {
+		t.Errorf("Normalize = %v, expected %v", p, expected)
+	}
+}
+
+func TestPlaneSignedDistance(t *testing.T) {
+	t.Parallel()
+
+	p := PlaneFromPointNormal(Vec3{1, 1, 1}, Vec3{1, 0, 0})
+
+	tests := []struct {
+		Point     Vec3
+		Distance  float32
+		Projected Vec3
+	}{
+		{Vec3{1, 5, 5}, 0, Vec3{1, 5, 5}},
+		{Vec3{4, 2, 3}, 3, Vec3{1, 2, 3}},
+		{Vec3{-1, 0, 0}, -2, Vec3{1, 0, 0}},
+	}
+
+	for _, c := range tests {
+		if d := p.SignedDistance(c.Point); !FloatEqual(d, c.Distance) {
+			t.Errorf("SignedDistance(%v) = %v, expected %v", c.Point, d, c.Distance)
+		}
+		if r := p.ProjectPoint(c.Point); !r.ApproxEqual(c.Projected) {
+			t.Errorf("ProjectPoint(%v) = %v, expected %v", c.Point, r, c.Projected)
+		}
+	}
+}
+
+func TestPlaneIntersectLine(t *testing.T) {
+	t.Parallel()
+
+	p := Plane{Vec3{0, 1, 0}, -1}
+
+	point, tr, ok := p.IntersectLine(Vec3{2, -1, 0}, Vec3{2, 3, 4})
+	if !ok || !point.ApproxEqual(Vec3{2, 1, 2}) || !FloatEqual(tr, 0.5) {
+		t.Errorf("IntersectLine = %v, %v, %v; expected %v, 0.5, true", point, tr, ok, Vec3{2, 1, 2})
+	}
+
+	point, tr, ok = p.IntersectLine(Vec3{0, 3, 0}, Vec3{0, 5, 0})
+	if !ok || !point.ApproxEqual(Vec3{0, 1, 0}) || !FloatEqual(tr, -1) {
+		t.Errorf("IntersectLine = %v, %v, %v; expected %v, -1, true", point, tr, ok, Vec3{0, 1, 0})
+	}
+
+	if _, _, ok := p.IntersectLine(Vec3{0, 3, 0}, Vec3{1, 3, 1}); ok {
+		t.Errorf("IntersectLine found intersection with a parallel line")
+	}
+}
+
+func TestPlaneIntersectPlane(t *testing.T) {
+	t.Parallel()
+
+	p1 := PlaneFromPointNormal(Vec3{0, 2, 0}, Vec3{0, 1, 0})
+	p2 := PlaneFromPointNormal(Vec3{3, 0, 0}, Vec3{1, 0, 0})
+
+	point, dir, ok := p1.IntersectPlane(p2)
+	if !ok {
+		t.Fatalf("IntersectPlane found no intersection")
+	}
+	if !point.ApproxEqual(Vec3{3, 2, 0}) {
+		t.Errorf("IntersectPlane point is %v, expected %v", point, Vec3{3, 2, 0})
+	}
+	if !dir.ApproxEqual(Vec3{0, 0, -1}) {
+		t.Errorf("IntersectPlane direction is %v, expected %v", dir, Vec3{0, 0, -1})
+	}
+
+	// Non-axis-aligned planes; the point must lie on both
+	p3 := PlaneFromPointNormal(Vec3{1, 2, 3}, Vec3{1, 1, 0})
+	p4 := PlaneFromPointNormal(Vec3{-1, 0, 4}, Vec3{0, 1, 1})
+	point, dir, ok = p3.IntersectPlane(p4)
+	if !ok {
+		t.Fatalf("IntersectPlane found no intersection")
+	}
+	for _, p := range []Plane{p3, p4} {
+		if d := p.SignedDistance(point); Abs(d) > 1e-5 {
+			t.Errorf("Point %v is not on plane %v (distance %v)", point, p, d)
+		}
+		if d := p.Normal.Dot(dir); Abs(d) > 1e-5 {
+			t.Errorf("Direction %v is not parallel to plane %v", dir, p)
+		}
+	}
+
+	if _, _, ok := p1.IntersectPlane(Plane{Vec3{0, 2, 0}, 1}); ok {
+		t.Errorf("IntersectPlane found intersection between parallel planes")
+	}
+}
+
+func TestIntersectPlanes(t *testing.T) {
+	t.Parallel()
+
+	p1 := PlaneFromPointNormal(Vec3{1, 2, 3}, Vec3{1, 0, 0})
+	p2 := PlaneFromPointNormal(Vec3{1, 2, 3}, Vec3{1, 1, 0})
+	p3 := PlaneFromPointNormal(Vec3{1, 2, 3}, Vec3{0, 1, 1})
+
+	if point, ok := IntersectPlanes(p1, p2, p3); !ok || !point.ApproxEqualThreshold(Vec3{1, 2, 3}, 1e-5) {
+		t.Errorf("IntersectPlanes = %v, %v; expected %v, true", point, ok, Vec3{1, 2, 3})
+	}
+
+	// Three planes sharing the Z axis
+	p4 := PlaneFromPointNormal(Vec3{}, Vec3{1, 1, 0})
+	if _, ok := IntersectPlanes(p1.Flip(), PlaneFromPointNormal(Vec3{}, Vec3{1, 0, 0}), p4); ok {
+		t.Errorf("IntersectPlanes found intersection of planes with parallel members")
+	}
+	if _, ok := IntersectPlanes(PlaneFromPointNormal(Vec3{}, Vec3{1, 0, 0}), PlaneFromPointNormal(Vec3{}, Vec3{0, 1, 0}), p4); ok {
+		t.Errorf("IntersectPlanes found a single intersection of planes sharing a line")
+	}
+}
+
+func TestPlaneReflection(t *testing.T) {
+	t.Parallel()
+
+	p := Plane{Vec3{0, 2, 0}, -2} // y = 1
+	m := p.Reflection()
+
+	tests := []struct {
+		Point, Expected Vec3
+	}{
+		{Vec3{0, 0, 0}, Vec3{0, 2, 0}},
+		{Vec3{3, 1, 4}, Vec3{3, 1, 4}},
+		{Vec3{-1, 5, 2}, Vec3{-1, -3, 2}},
+	}
+
+	for _, c := range tests {
+		if r := TransformCoordinate(c.Point, m); !r.ApproxEqualThreshold(c.Expected, 1e-6) {
+			t.Errorf("Reflection of %v is %v, expected %v", c.Point, r, c.Expected)
+		}
+	}
+
+	if det := m.Det(); !FloatEqual(det, -1) {
+		t.Errorf("Reflection matrix has determinant %v, expected -1", det)
+	}
+}
+
+func TestPlaneTransform(t *testing.T) {
+	t.Parallel()
+
+	p := PlaneFromPoints(Vec3{1, 0, 0}, Vec3{0, 1, 0}, Vec3{0, 0, 1})
+	m := Translate3D(1, -2, 3).Mul4(HomogRotate3D(math.Pi/3, Vec3{0, 1, 0})).Mul4(Scale3D(2, 1, 0.5))
+
+	transformed := p.Transform(m)
+	for _, point := range []Vec3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {1, 1, -1}} {
+		if d := transformed.SignedDistance(TransformCoordinate(point, m)); Abs(d) > 1e-5 {
+			t.Errorf("Transformed point %v is not on transformed plane (distance %v)", point, d)
+		}
+	}
+
+	// The side of points is preserved
+	above := Vec3{1, 1, 1}
+	if transformed.SignedDistance(TransformCoordinate(above, m)) <= 0 {
+		t.Errorf("Transform flipped the side of %v", above)
+	}
+}
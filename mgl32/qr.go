@@ -0,0 +1,267 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// machineEpsilon is the difference between 1 and the next representable
+// float32, the upper bound on the relative rounding error of a single
+// operation.
+var machineEpsilon = math.Nextafter32(1, 2) - 1
+
+// QR is the QR decomposition of an MxN MatMxN A with M >= N. That is, A = QR
+// where Q is an MxN matrix with orthonormal columns and R is an NxN upper
+// triangular matrix.
+//
+// The decomposition is computed with Householder reflections, which are stored
+// in compact form below the diagonal of a single matrix, with the diagonal of R
+// kept separately. It is mostly useful for solving over-determined systems in
+// the least squares sense, see QR.Solve and LeastSquares.
+type QR struct {
+	qr    *MatMxN
+	rdiag []float32
+}
+
+// QR computes the QR decomposition of mat using Householder reflections. The
+// decomposition is stored in dst, reusing its memory if possible. If dst is
+// nil, a new QR will be allocated. The value returned is dst.
+//
+// If mat is nil a NilMatrixError is returned, and if it has fewer rows than
+// columns an UnderdeterminedError. A rank deficient matrix still has a QR
+// decomposition; QR.IsFullRank will report it, and QR.Solve will return a
+// SingularMatrixError.
+func (mat *MatMxN) QR(dst *QR) (*QR, error) {
+	if mat == nil {
+		return nil, NilMatrixError{}
+	}
+	if mat.m < mat.n {
+		return nil, UnderdeterminedError{}
+	}
+
+	if dst == nil {
+		dst = &QR{}
+	}
+	if dst.qr == nil {
+		dst.qr = NewMatrix(mat.m, mat.n)
+	}
+	CopyMatMN(dst.qr, mat)
+
+	if cap(dst.rdiag) < mat.n {
+		dst.rdiag = make([]float32, mat.n)
+	}
+	dst.rdiag = dst.rdiag[:mat.n]
+
+	dst.decompose()
+
+	return dst, nil
+}
+
+// decompose performs the Householder reflections in place on qr.qr, which must
+// already hold a copy of the matrix being decomposed.
+func (qr *QR) decompose() {
+	a, m, n := qr.qr, qr.qr.m, qr.qr.n
+
+	for k := 0; k < n; k++ {
+		col := a.dat[k*m : (k+1)*m]
+
+		// Norm of the part of column k on and below the diagonal, without
+		// under- or overflow
+		var nrm float64
+		for i := k; i < m; i++ {
+			nrm = math.Hypot(nrm, float64(col[i]))
+		}
+
+		if nrm != 0 {
+			// Reflect in the direction that avoids cancellation
+			if col[k] < 0 {
+				nrm = -nrm
+			}
+			for i := k; i < m; i++ {
+				col[i] /= float32(nrm)
+			}
+			col[k]++
+
+			// Apply the reflection to the remaining columns
+			for j := k + 1; j < n; j++ {
+				other := a.dat[j*m : (j+1)*m]
+				var s float32
+				for i := k; i < m; i++ {
+					s += col[i] * other[i]
+				}
+				s = -s / col[k]
+				for i := k; i < m; i++ {
+					other[i] += s * col[i]
+				}
+			}
+		}
+
+		qr.rdiag[k] = float32(-nrm)
+	}
+}
+
+// Dims returns the number of rows and columns of the decomposed matrix.
+func (qr *QR) Dims() (rows, cols int) {
+	return qr.qr.m, qr.qr.n
+}
+
+// IsFullRank returns whether the columns of the decomposed matrix are linearly
+// independent. Because the rounding errors of the reflections make the
+// diagonal of R unlikely to be exactly zero even for dependent columns, an
+// element of the diagonal counts as zero if it's smaller than the largest one
+// by a factor of about the number of rows times the machine precision.
+func (qr *QR) IsFullRank() bool {
+	var max float32
+	for _, d := range qr.rdiag {
+		if Abs(d) > max {
+			max = Abs(d)
+		}
+	}
+
+	tol := float32(qr.qr.m) * machineEpsilon * max
+	for _, d := range qr.rdiag {
+		if Abs(d) <= tol {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Q stores the orthogonal factor of the decomposition in dst, which is
+// Reshaped as necessary, and returns it. This is the "thin" Q, an MxN matrix
+// whose columns are orthonormal.
+func (qr *QR) Q(dst *MatMxN) *MatMxN {
+	a, m, n := qr.qr, qr.qr.m, qr.qr.n
+	dst = dst.Reshape(m, n)
+
+	for k := n - 1; k >= 0; k-- {
+		for i := 0; i < m; i++ {
+			dst.Set(i, k, 0)
+		}
+		dst.Set(k, k, 1)
+
+		col := a.dat[k*m : (k+1)*m]
+		if col[k] == 0 {
+			continue
+		}
+
+		for j := k; j < n; j++ {
+			other := dst.dat[j*m : (j+1)*m]
+			var s float32
+			for i := k; i < m; i++ {
+				s += col[i] * other[i]
+			}
+			s = -s / col[k]
+			for i := k; i < m; i++ {
+				other[i] += s * col[i]
+			}
+		}
+	}
+
+	return dst
+}
+
+// R stores the upper triangular factor of the decomposition in dst, which is
+// Reshaped as necessary, and returns it. R is an NxN matrix.
+func (qr *QR) R(dst *MatMxN) *MatMxN {
+	n := qr.qr.n
+	dst = dst.Reshape(n, n)
+
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			switch {
+			case i == j:
+				dst.Set(i, j, qr.rdiag[i])
+			case i < j:
+				dst.Set(i, j, qr.qr.At(i, j))
+			default:
+				dst.Set(i, j, 0)
+			}
+		}
+	}
+
+	return dst
+}
+
+// Solve finds the x minimizing the length of Ax - b, where A is the decomposed
+// matrix, and stores it in dst, which is Resized as necessary. If A is square
+// this is the exact solution of Ax = b. Dst may be b. The size of b must be the
+// number of rows of A, and the size of the result is the number of columns.
+//
+// If the matrix is rank deficient, this returns a SingularMatrixError. If b is
+// nil or its size does not match the matrix, this returns a
+// DimensionMismatchError.
+func (qr *QR) Solve(dst, b *VecN) (*VecN, error) {
+	a, m, n := qr.qr, qr.qr.m, qr.qr.n
+	if b == nil || len(b.vec) != m {
+		return nil, DimensionMismatchError{}
+	}
+	if !qr.IsFullRank() {
+		return nil, SingularMatrixError{}
+	}
+
+	x := NewVecN(m)
+	defer x.destroy()
+	copy(x.vec, b.vec)
+
+	// Q^T * b
+	for k := 0; k < n; k++ {
+		col := a.dat[k*m : (k+1)*m]
+		var s float32
+		for i := k; i < m; i++ {
+			s += col[i] * x.vec[i]
+		}
+		s = -s / col[k]
+		for i := k; i < m; i++ {
+			x.vec[i] += s * col[i]
+		}
+	}
+
+	// Rx = Q^T * b
+	for k := n - 1; k >= 0; k-- {
+		x.vec[k] /= qr.rdiag[k]
+		for i := 0; i < k; i++ {
+			x.vec[i] -= x.vec[k] * a.At(i, k)
+		}
+	}
+
+	dst = dst.Resize(n)
+	copy(dst.vec, x.vec[:n])
+
+	return dst, nil
+}
+
+// destroy returns the memory backing the decomposition to the memory pool.
+func (qr *QR) destroy() {
+	qr.qr.destroy()
+	qr.qr = nil
+}
+
+// LeastSquares finds the x minimizing the length of a*x - b, the least squares
+// solution of the (typically over-determined) system a*x = b, and stores it in
+// dst, which is Resized as necessary. Dst may be b. The matrix a must have at
+// least as many rows as columns; b must have as many elements as a has rows,
+// and the result has as many elements as a has columns.
+//
+// This is solved through the QR decomposition of a, which is more accurate than
+// solving the normal equations a^T*a*x = a^T*b. The memory used during the
+// computation is taken from, and returned to, the memory pool. To solve for
+// several vectors b with the same matrix, compute the QR decomposition once and
+// use QR.Solve.
+//
+// If a is nil, has fewer rows than columns, or is rank deficient, this returns a
+// NilMatrixError, UnderdeterminedError or SingularMatrixError respectively. If
+// b does not match the size of a, a DimensionMismatchError is returned.
+func LeastSquares(dst *VecN, a *MatMxN, b *VecN) (*VecN, error) {
+	var qr QR
+	if _, err := a.QR(&qr); err != nil {
+		return nil, err
+	}
+	defer qr.destroy()
+
+	return qr.Solve(dst, b)
+}
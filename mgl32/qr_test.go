@@ -0,0 +1,129 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestQRFactors(t *testing.T) {
+	m := NewMatrixFromData([]float32{
+		1, 2, 3, 4,
+		0, 1, 5, 2,
+		2, -1, 0, 1,
+	}, 4, 3)
+
+	qr, err := m.QR(nil)
+	if err != nil {
+		t.Fatalf("QR returned error: %v", err)
+	}
+	if !qr.IsFullRank() {
+		t.Errorf("QR reports full rank matrix as rank deficient")
+	}
+	if rows, cols := qr.Dims(); rows != 4 || cols != 3 {
+		t.Errorf("QR has dimensions %dx%d, expected 4x3", rows, cols)
+	}
+
+	q, r := qr.Q(nil), qr.R(nil)
+	near := func(a, b float32) bool { return Abs(a-b) < 1e-5 }
+
+	if prod := q.MulMxN(nil, r); !prod.ApproxEqualFunc(m, near) {
+		t.Errorf("Q * R is %v, expected %v", prod, m)
+	}
+	if qtq := q.Transpose(nil).MulMxN(nil, q); !qtq.ApproxEqualFunc(IdentN(nil, 3), near) {
+		t.Errorf("Q^T * Q is %v, expected identity", qtq)
+	}
+	for j := 0; j < 3; j++ {
+		for i := j + 1; i < 3; i++ {
+			if r.At(i, j) != 0 {
+				t.Errorf("R is not upper triangular: %v", r)
+			}
+		}
+	}
+}
+
+func TestQRErrors(t *testing.T) {
+	var nilMat *MatMxN
+	if _, err := nilMat.QR(nil); err != (NilMatrixError{}) {
+		t.Errorf("QR of nil matrix returned %v, expected NilMatrixError", err)
+	}
+	if _, err := NewMatrix(2, 3).QR(nil); err != (UnderdeterminedError{}) {
+		t.Errorf("QR of wide matrix returned %v, expected UnderdeterminedError", err)
+	}
+
+	deficient := NewMatrixFromData([]float32{1, 2, 3, 2, 4, 6}, 3, 2)
+	qr, err := deficient.QR(nil)
+	if err != nil {
+		t.Fatalf("QR returned error: %v", err)
+	}
+	if qr.IsFullRank() {
+		t.Errorf("QR reports rank deficient matrix as full rank")
+	}
+	if _, err := qr.Solve(nil, NewVecN(3)); err != (SingularMatrixError{}) {
+		t.Errorf("Solve with rank deficient matrix returned %v, expected SingularMatrixError", err)
+	}
+	if _, err := qr.Solve(nil, NewVecN(2)); err != (DimensionMismatchError{}) {
+		t.Errorf("Solve with wrong sized vector returned %v, expected DimensionMismatchError", err)
+	}
+}
+
+func TestLeastSquares(t *testing.T) {
+	// Fit y = a + b*x to points that lie exactly on y = 1 + 2x, and to points
+	// scattered symmetrically around it
+	xs := []float32{0, 1, 2, 3, 4}
+	a := NewMatrix(len(xs), 2)
+	for i, x := range xs {
+		a.Set(i, 0, 1)
+		a.Set(i, 1, x)
+	}
+
+	tests := []struct {
+		Ys       []float32
+		Expected []float32
+	}{
+		{[]float32{1, 3, 5, 7, 9}, []float32{1, 2}},
+		{[]float32{1.5, 2, 5, 8, 8.5}, []float32{1, 2}},
+		{[]float32{2, 2, 2, 2, 2}, []float32{2, 0}},
+	}
+
+	near := func(a, b float32) bool { return Abs(a-b) < 1e-5 }
+	for _, c := range tests {
+		b := NewVecNFromData(c.Ys)
+		result, err := LeastSquares(nil, a, b)
+		if err != nil {
+			t.Errorf("LeastSquares returned error: %v", err)
+			continue
+		}
+		if expected := NewVecNFromData(c.Expected); !result.ApproxEqualFunc(expected, near) {
+			t.Errorf("LeastSquares fit of %v is %v, expected %v", c.Ys, result.Raw(), c.Expected)
+		}
+	}
+
+	// Square systems are solved exactly, also in place
+	m := NewMatrixFromData([]float32{0, 1, 3, 1, 0, 2, 2, 1, 0}, 3, 3)
+	x := NewVecNFromData([]float32{1, -2, 3})
+	b := m.MulNx1(nil, x)
+	if _, err := LeastSquares(b, m, b); err != nil || !b.ApproxEqualFunc(x, near) {
+		t.Errorf("LeastSquares of square system gives %v (err %v), expected %v", b.Raw(), err, x.Raw())
+	}
+}
+
+func BenchmarkLeastSquares(b *testing.B) {
+	a := NewMatrix(100, 4)
+	for i := 0; i < 100; i++ {
+		x := float32(i) / 100
+		a.Set(i, 0, 1)
+		a.Set(i, 1, x)
+		a.Set(i, 2, x*x)
+		a.Set(i, 3, x*x*x)
+	}
+	y := NewVecN(100)
+	dst := NewVecN(4)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		LeastSquares(dst, a, y)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// Ray3 is a half-line in 3D space starting at Origin and extending infinitely
+// in the direction Dir.
+//
+// Dir does not need to be normalized, but all distances ("t" values) reported
+// by the intersection methods are measured in multiples of Dir. That is, the
+// point of intersection is always r.At(t), and t is only the actual distance
+// from Origin if Dir has a length of 1.
+type Ray3 struct {
+	Origin, Dir Vec3
+}
+
+// PickRay builds a ray from the camera through the given window coordinates,
+// for instance the position of the mouse cursor. It takes the same arguments as
+// UnProject, and is equivalent to unprojecting (winX, winY) at the near (0) and
+// far (1) depth and taking the ray between them. The direction of the returned
+// ray is normalized, so distances reported by the intersection methods are
+// in world (object) units.
+//
+// If projection times modelview is not invertible, this returns an error.
+func PickRay(winX, winY float32, modelview, projection Mat4, initialX, initialY, width, height int) (Ray3, error) {
+	near, err := UnProject(Vec3{winX, winY, 0}, modelview, projection, initialX, initialY, width, height)
+	if err != nil {
+		return Ray3{}, err
+	}
+	far, err := UnProject(Vec3{winX, winY, 1}, modelview, projection, initialX, initialY, width, height)
+	if err != nil {
+		return Ray3{}, err
+	}
+
+	return Ray3{near, far.Sub(near).Normalize()}, nil
+}
+
+// At returns the point on the ray at parameter t, which is Origin + Dir*t.
+func (r Ray3) At(t float32) Vec3 {
+	return r.Origin.Add(r.Dir.Mul(t))
+}
+
+// IntersectPlane intersects the ray with a plane, which does not need to be
+// normalized.
+//
+// It returns the parameter of the intersection point and whether there is
+// one. A ray parallel to the plane, or a plane behind the origin of the ray,
+// does not intersect.
+func (r Ray3) IntersectPlane(p Plane) (t float32, ok bool) {
+	denom := p.Normal.Dot(r.Dir)
+	if Abs(denom) < Epsilon {
+		return 0, false
+	}
+
+	t = -p.SignedDistance(r.Origin) / denom
+	if t < 0 {
+		return 0, false
+	}
+
+	return t, true
+}
+
+// IntersectSphere intersects the ray with the sphere of the given center and
+// radius.
+//
+// It returns the parameter of the first point where the ray hits the surface
+// of the sphere. If the origin of the ray is inside the sphere, this is the
+// point where the ray exits it. If the sphere is missed entirely, or lies
+// behind the ray's origin, ok is false.
+func (r Ray3) IntersectSphere(center Vec3, radius float32) (t float32, ok bool) {
+	oc := r.Origin.Sub(center)
+	a := r.Dir.LenSqr()
+	b := oc.Dot(r.Dir)
+	c := oc.LenSqr() - radius*radius
+
+	// Origin outside the sphere and pointing away from it
+	if c > 0 && b > 0 {
+		return 0, false
+	}
+
+	disc := b*b - a*c
+	if disc < 0 || a == 0 {
+		return 0, false
+	}
+
+	sq := float32(math.Sqrt(float64(disc)))
+	t = (-b - sq) / a
+	if t < 0 {
+		t = (-b + sq) / a
+	}
+
+	return t, t >= 0
+}
+
+// IntersectAABB intersects the ray with an axis-aligned box using the slab
+// method: the ray is clipped against the pair of planes bounding the box on
+// each axis, and it hits the box if the intervals it spends between each pair
+// of planes overlap.
+//
+// It returns the parameters at which the ray enters and exits the box. If the
+// origin is inside the box, tNear is 0. If the box is missed, is behind the
+// ray, or is empty, ok is false.
+func (r Ray3) IntersectAABB(b AABB3) (tNear, tFar float32, ok bool) {
+	if b.IsEmpty() {
+		// The slabs of an inverted box would swap into a valid interval
+		return 0, 0, false
+	}
+	tNear, tFar = 0, InfPos
+
+	for i := 0; i < 3; i++ {
+		if r.Dir[i] == 0 {
+			// Parallel to this slab; it has to start between the planes
+			if r.Origin[i] < b.Min[i] || r.Origin[i] > b.Max[i] {
+				return 0, 0, false
+			}
+			continue
+		}
+
+		inv := 1 / r.Dir[i]
+		t1 := (b.Min[i] - r.Origin[i]) * inv
+		t2 := (b.Max[i] - r.Origin[i]) * inv
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+
+		SetMax(&tNear, &t1)
+		SetMin(&tFar, &t2)
+		if tNear > tFar {
+			return 0, 0, false
+		}
+	}
+
+	return tNear, tFar, true
+}
+
+// IntersectTriangle intersects the ray with the triangle (v0, v1, v2) using
+// the Möller–Trumbore algorithm. Both sides of the triangle are considered.
+//
+// It returns the parameter of the intersection along with its barycentric
+// coordinates u and v, such that the intersection point is equal to
+// v0.Mul(1-u-v).Add(v1.Mul(u)).Add(v2.Mul(v)). If the ray misses the triangle,
+// is parallel to it, or the triangle is behind the origin, ok is false.
+func (r Ray3) IntersectTriangle(v0, v1, v2 Vec3) (t, u, v float32, ok bool) {
+	e1 := v1.Sub(v0)
+	e2 := v2.Sub(v0)
+
+	p := r.Dir.Cross(e2)
+	det := e1.Dot(p)
+	if Abs(det) < Epsilon {
+		return 0, 0, 0, false
+	}
+	invDet := 1 / det
+
+	s := r.Origin.Sub(v0)
+	u = s.Dot(p) * invDet
+	if u < 0 || u > 1 {
+		return 0, 0, 0, false
+	}
+
+	q := s.Cross(e1)
+	v = r.Dir.Dot(q) * invDet
+	if v < 0 || u+v > 1 {
+		return 0, 0, 0, false
+	}
+
+	t = e2.Dot(q) * invDet
+	if t < 0 {
+		return 0, 0, 0, false
+	}
+
+	return t, u, v, true
+}
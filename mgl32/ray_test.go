@@ -0,0 +1,171 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestPickRay(t *testing.T) {
+	t.Parallel()
+
+	projection := Perspective(DegToRad(45), 800.0/600.0, 0.1, 100)
+	camera := LookAtV(Vec3{0, 0, 10}, Vec3{0, 0, 0}, Vec3{0, 1, 0})
+
+	// The center of the screen looks straight down -Z
+	r, err := PickRay(400, 300, camera, projection, 0, 0, 800, 600)
+	if err != nil {
+		t.Fatalf("PickRay returned error: %v", err)
+	}
+	if !r.Dir.ApproxEqualThreshold(Vec3{0, 0, -1}, 1e-4) {
+		t.Errorf("PickRay through screen center has direction %v, expected %v", r.Dir, Vec3{0, 0, -1})
+	}
+	if !r.Origin.ApproxEqualThreshold(Vec3{0, 0, 9.9}, 1e-4) {
+		t.Errorf("PickRay through screen center has origin %v, expected %v", r.Origin, Vec3{0, 0, 9.9})
+	}
+
+	// A ray through the projection of a point must pass through that point
+	obj := Vec3{1, 2, -3}
+	win := Project(obj, camera, projection, 0, 0, 800, 600)
+	r, err = PickRay(win[0], win[1], camera, projection, 0, 0, 800, 600)
+	if err != nil {
+		t.Fatalf("PickRay returned error: %v", err)
+	}
+	toObj := obj.Sub(r.Origin)
+	if closest := r.At(toObj.Dot(r.Dir)); !closest.ApproxEqualThreshold(obj, 1e-3) {
+		t.Errorf("PickRay through projection of %v passes through %v instead", obj, closest)
+	}
+
+	if _, err := PickRay(0, 0, Mat4{}, Mat4{}, 0, 0, 800, 600); err == nil {
+		t.Errorf("PickRay did not return error for singular matrix")
+	}
+}
+
+func TestRayIntersectPlane(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Ray   Ray3
+		Plane Plane
+		T     float32
+		Ok    bool
+	}{
+		{Ray3{Vec3{0, 5, 0}, Vec3{0, -1, 0}}, Plane{Vec3{0, 1, 0}, 0}, 5, true},
+		{Ray3{Vec3{0, 5, 0}, Vec3{0, -2, 0}}, Plane{Vec3{0, 1, 0}, -1}, 2, true},
+		{Ray3{Vec3{0, 5, 0}, Vec3{0, 1, 0}}, Plane{Vec3{0, 1, 0}, 0}, 0, false},
+		{Ray3{Vec3{0, 5, 0}, Vec3{1, 0, 0}}, Plane{Vec3{0, 1, 0}, 0}, 0, false},
+		{Ray3{Vec3{1, 1, 1}, Vec3{-1, -1, -1}}, Plane{Vec3{1, 1, 1}, 0}, 1, true},
+	}
+
+	for _, c := range tests {
+		tr, ok := c.Ray.IntersectPlane(c.Plane)
+		if ok != c.Ok || (ok && !FloatEqualThreshold(tr, c.T, 1e-5)) {
+			t.Errorf("%v.IntersectPlane(%v) = %v, %v; expected %v, %v", c.Ray, c.Plane, tr, ok, c.T, c.Ok)
+		}
+	}
+}
+
+func TestRayIntersectSphere(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Ray    Ray3
+		Center Vec3
+		Radius float32
+		T      float32
+		Ok     bool
+	}{
+		{Ray3{Vec3{-5, 0, 0}, Vec3{1, 0, 0}}, Vec3{}, 1, 4, true},
+		{Ray3{Vec3{-5, 0, 0}, Vec3{2, 0, 0}}, Vec3{}, 1, 2, true},
+		{Ray3{Vec3{0, 0, 0}, Vec3{1, 0, 0}}, Vec3{}, 1, 1, true},
+		{Ray3{Vec3{5, 0, 0}, Vec3{1, 0, 0}}, Vec3{}, 1, 0, false},
+		{Ray3{Vec3{-5, 2, 0}, Vec3{1, 0, 0}}, Vec3{}, 1, 0, false},
+		{Ray3{Vec3{-5, 1, 0}, Vec3{1, 0, 0}}, Vec3{}, 1, 5, true},
+	}
+
+	for _, c := range tests {
+		tr, ok := c.Ray.IntersectSphere(c.Center, c.Radius)
+		if ok != c.Ok || (ok && !FloatEqualThreshold(tr, c.T, 1e-5)) {
+			t.Errorf("%v.IntersectSphere(%v, %v) = %v, %v; expected %v, %v", c.Ray, c.Center, c.Radius, tr, ok, c.T, c.Ok)
+		}
+	}
+}
+
+func TestRayIntersectAABB(t *testing.T) {
+	t.Parallel()
+
+	b := AABB3{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}
+
+	tests := []struct {
+		Ray       Ray3
+		Near, Far float32
+		Ok        bool
+	}{
+		{Ray3{Vec3{-5, 0, 0}, Vec3{1, 0, 0}}, 4, 6, true},
+		{Ray3{Vec3{0, 0, 0}, Vec3{0, 1, 0}}, 0, 1, true},
+		{Ray3{Vec3{-5, -5, -5}, Vec3{1, 1, 1}}, 4, 6, true},
+		{Ray3{Vec3{-5, 2, 0}, Vec3{1, 0, 0}}, 0, 0, false},
+		{Ray3{Vec3{5, 0, 0}, Vec3{1, 0, 0}}, 0, 0, false},
+		{Ray3{Vec3{-5, 1, 0}, Vec3{1, 0, 0}}, 4, 6, true},
+		{Ray3{Vec3{-5, 0, 0}, Vec3{1, 1, 0}}, 0, 0, false},
+	}
+
+	for _, c := range tests {
+		near, far, ok := c.Ray.IntersectAABB(b)
+		if ok != c.Ok || (ok && (!FloatEqual(near, c.Near) || !FloatEqual(far, c.Far))) {
+			t.Errorf("%v.IntersectAABB(%v) = %v, %v, %v; expected %v, %v, %v", c.Ray, b, near, far, ok, c.Near, c.Far, c.Ok)
+		}
+	}
+
+	for _, ray := range []Ray3{{Vec3{}, Vec3{1, 0, 0}}, {Vec3{}, Vec3{1, 2, 3}}, {Vec3{-5, -5, -5}, Vec3{1, 1, 1}}} {
+		if _, _, ok := ray.IntersectAABB(EmptyAABB3()); ok {
+			t.Errorf("%v intersects empty box", ray)
+		}
+		if _, _, ok := ray.IntersectAABB(AABB3{Vec3{1, 1, 1}, Vec3{0.5, 0.5, 0.5}}); ok {
+			t.Errorf("%v intersects inverted box", ray)
+		}
+	}
+}
+
+func TestRayIntersectTriangle(t *testing.T) {
+	t.Parallel()
+
+	v0, v1, v2 := Vec3{0, 0, 0}, Vec3{2, 0, 0}, Vec3{0, 2, 0}
+
+	tests := []struct {
+		Ray     Ray3
+		T, U, V float32
+		Ok      bool
+	}{
+		{Ray3{Vec3{0.5, 0.5, 3}, Vec3{0, 0, -1}}, 3, 0.25, 0.25, true},
+		{Ray3{Vec3{0.5, 0.5, -3}, Vec3{0, 0, 1}}, 3, 0.25, 0.25, true},
+		{Ray3{Vec3{1, 0.5, 2}, Vec3{0, 0, -2}}, 1, 0.5, 0.25, true},
+		{Ray3{Vec3{2, 2, 3}, Vec3{0, 0, -1}}, 0, 0, 0, false},
+		{Ray3{Vec3{0.5, 0.5, 3}, Vec3{0, 0, 1}}, 0, 0, 0, false},
+		{Ray3{Vec3{0.5, 0.5, 3}, Vec3{1, 0, 0}}, 0, 0, 0, false},
+	}
+
+	for _, c := range tests {
+		tr, u, v, ok := c.Ray.IntersectTriangle(v0, v1, v2)
+		if ok != c.Ok || (ok && (!FloatEqual(tr, c.T) || !FloatEqual(u, c.U) || !FloatEqual(v, c.V))) {
+			t.Errorf("%v.IntersectTriangle = %v, %v, %v, %v; expected %v, %v, %v, %v", c.Ray, tr, u, v, ok, c.T, c.U, c.V, c.Ok)
+		}
+		if ok {
+			bary := v0.Mul(1 - u - v).Add(v1.Mul(u)).Add(v2.Mul(v))
+			if !bary.ApproxEqualThreshold(c.Ray.At(tr), 1e-5) {
+				t.Errorf("Barycentric point %v does not match hit point %v", bary, c.Ray.At(tr))
+			}
+		}
+	}
+}
+
+func BenchmarkRayIntersectTriangle(b *testing.B) {
+	r := Ray3{Vec3{0.5, 0.5, 3}, Vec3{0, 0, -1}}
+	v0, v1, v2 := Vec3{0, 0, 0}, Vec3{2, 0, 0}, Vec3{0, 2, 0}
+
+	for i := 0; i < b.N; i++ {
+		r.IntersectTriangle(v0, v1, v2)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && !purego
+// +build amd64,!purego
+
+package mgl32
+
+// On amd64, Mat4.Mul4, Mat4.Mul4x1, Mat4.Inv and the batch functions are
+// implemented in assembly (simd_amd64.s). SSE2 is part of the amd64 baseline,
+// so the SSE kernels are always used, except where an AVX kernel exists and
+// the CPU and operating system support AVX. Build with the purego tag to use
+// the pure Go versions instead.
+//
+// The kernels perform the same operations in the same order as the pure Go
+// versions, except for Mat4.Inv, which uses a different (but equally accurate)
+// formula. The results may still differ in the last bits, since the compiler
+// fuses multiplies and adds in the Go versions when it targets CPUs that have
+// FMA (GOAMD64=v3 and up), and the kernels don't.
+
+// hasAVX is whether the AVX kernels can be used.
+var hasAVX = detectAVX()
+
+func detectAVX() bool {
+	_, _, ecx, _ := cpuid(1, 0)
+	const osxsave, avx = 1 << 27, 1 << 28
+	if ecx&osxsave == 0 || ecx&avx == 0 {
+		return false
+	}
+
+	// The operating system must save the XMM and YMM registers
+	eax, _ := xgetbv()
+	return eax&6 == 6
+}
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+//go:noescape
+func mat4Mul4SSE(dst, m1, m2 *Mat4)
+
+//go:noescape
+func mat4Mul4AVX(dst, m1, m2 *Mat4)
+
+//go:noescape
+func mat4Mul4x1SSE(dst *Vec4, m1 *Mat4, m2 *Vec4)
+
+//go:noescape
+func mat4InvSSE(dst, m *Mat4) float32
+
+//go:noescape
+func transformCoordinatesSSE(dst, src []Vec3, m *Mat4)
+
+//go:noescape
+func transformNormalsSSE(dst, src []Vec3, m *Mat4)
+
+//go:noescape
+func normalizeAllSSE(vs []Vec3)
+
+//go:noescape
+func mulMat4BatchSSE(dst, a, b []Mat4)
+
+//go:noescape
+func mulMat4BatchAVX(dst, a, b []Mat4)
+
+func mat4Mul4(dst, m1, m2 *Mat4) {
+	if hasAVX {
+		mat4Mul4AVX(dst, m1, m2)
+		return
+	}
+	mat4Mul4SSE(dst, m1, m2)
+}
+
+func mat4Mul4x1(dst *Vec4, m1 *Mat4, m2 *Vec4) {
+	mat4Mul4x1SSE(dst, m1, m2)
+}
+
+func mat4Inv(dst, m *Mat4) float32 {
+	return mat4InvSSE(dst, m)
+}
+
+func transformCoordinates(dst, src []Vec3, m *Mat4) {
+	transformCoordinatesSSE(dst, src, m)
+}
+
+func transformNormals(dst, src []Vec3, m *Mat4) {
+	transformNormalsSSE(dst, src, m)
+}
+
+func normalizeAll(vs []Vec3) {
+	normalizeAllSSE(vs)
+}
+
+func mulMat4Batch(dst, a, b []Mat4) {
+	if hasAVX {
+		mulMat4BatchAVX(dst, a, b)
+		return
+	}
+	mulMat4BatchSSE(dst, a, b)
+}
@@ -0,0 +1,408 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && !purego
+// +build amd64,!purego
+
+#include "textflag.h"
+
+DATA ones<>+0x00(SB)/4, $0x3f800000
+DATA ones<>+0x04(SB)/4, $0x3f800000
+DATA ones<>+0x08(SB)/4, $0x3f800000
+DATA ones<>+0x0c(SB)/4, $0x3f800000
+GLOBL ones<>(SB), RODATA|NOPTR, $16
+
+// Sign masks negating the odd and the even elements
+DATA signodd<>+0x00(SB)/4, $0
+DATA signodd<>+0x04(SB)/4, $0x80000000
+DATA signodd<>+0x08(SB)/4, $0
+DATA signodd<>+0x0c(SB)/4, $0x80000000
+GLOBL signodd<>(SB), RODATA|NOPTR, $16
+
+DATA signeven<>+0x00(SB)/4, $0x80000000
+DATA signeven<>+0x04(SB)/4, $0
+DATA signeven<>+0x08(SB)/4, $0x80000000
+DATA signeven<>+0x0c(SB)/4, $0
+GLOBL signeven<>(SB), RODATA|NOPTR, $16
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
+
+// MUL4COL multiplies the matrix with the columns X0-X3 by the vector at
+// off(DX), storing the result at off(DI). It uses X4-X6.
+#define MUL4COL(off) \
+	MOVUPS off(DX), X4; \
+	MOVAPS X4, X5; SHUFPS $0x00, X5, X5; MULPS X0, X5; \
+	MOVAPS X4, X6; SHUFPS $0x55, X6, X6; MULPS X1, X6; ADDPS X6, X5; \
+	MOVAPS X4, X6; SHUFPS $0xAA, X6, X6; MULPS X2, X6; ADDPS X6, X5; \
+	SHUFPS $0xFF, X4, X4; MULPS X3, X4; ADDPS X4, X5; \
+	MOVUPS X5, off(DI)
+
+// MUL4SSE stores the product of the matrices at SI and DX at DI.
+#define MUL4SSE \
+	MOVUPS 0(SI), X0; \
+	MOVUPS 16(SI), X1; \
+	MOVUPS 32(SI), X2; \
+	MOVUPS 48(SI), X3; \
+	MUL4COL(0); \
+	MUL4COL(16); \
+	MUL4COL(32); \
+	MUL4COL(48)
+
+// MUL4AVX stores the product of the matrices at SI and DX at DI, computing
+// two columns at once: every column of the left matrix is in both halves of
+// Y0-Y3, and each half of Y4 and Y5 holds a column of the right matrix. It
+// uses Y0-Y7.
+#define MUL4AVX \
+	VBROADCASTF128 0(SI), Y0; \
+	VBROADCASTF128 16(SI), Y1; \
+	VBROADCASTF128 32(SI), Y2; \
+	VBROADCASTF128 48(SI), Y3; \
+	VMOVUPS 0(DX), Y4; \
+	VMOVUPS 32(DX), Y5; \
+	VPERMILPS $0x00, Y4, Y6; VMULPS Y0, Y6, Y6; \
+	VPERMILPS $0x55, Y4, Y7; VMULPS Y1, Y7, Y7; VADDPS Y7, Y6, Y6; \
+	VPERMILPS $0xAA, Y4, Y7; VMULPS Y2, Y7, Y7; VADDPS Y7, Y6, Y6; \
+	VPERMILPS $0xFF, Y4, Y7; VMULPS Y3, Y7, Y7; VADDPS Y7, Y6, Y6; \
+	VMOVUPS Y6, 0(DI); \
+	VPERMILPS $0x00, Y5, Y6; VMULPS Y0, Y6, Y6; \
+	VPERMILPS $0x55, Y5, Y7; VMULPS Y1, Y7, Y7; VADDPS Y7, Y6, Y6; \
+	VPERMILPS $0xAA, Y5, Y7; VMULPS Y2, Y7, Y7; VADDPS Y7, Y6, Y6; \
+	VPERMILPS $0xFF, Y5, Y7; VMULPS Y3, Y7, Y7; VADDPS Y7, Y6, Y6; \
+	VMOVUPS Y6, 32(DI)
+
+// func mat4Mul4SSE(dst, m1, m2 *Mat4)
+TEXT ·mat4Mul4SSE(SB), NOSPLIT, $0-24
+	MOVQ dst+0(FP), DI
+	MOVQ m1+8(FP), SI
+	MOVQ m2+16(FP), DX
+	MUL4SSE
+	RET
+
+// func mat4Mul4AVX(dst, m1, m2 *Mat4)
+TEXT ·mat4Mul4AVX(SB), NOSPLIT, $0-24
+	MOVQ dst+0(FP), DI
+	MOVQ m1+8(FP), SI
+	MOVQ m2+16(FP), DX
+	MUL4AVX
+	VZEROUPPER
+	RET
+
+// func mat4Mul4x1SSE(dst *Vec4, m1 *Mat4, m2 *Vec4)
+TEXT ·mat4Mul4x1SSE(SB), NOSPLIT, $0-24
+	MOVQ dst+0(FP), DI
+	MOVQ m1+8(FP), SI
+	MOVQ m2+16(FP), DX
+	MOVUPS 0(SI), X0
+	MOVUPS 16(SI), X1
+	MOVUPS 32(SI), X2
+	MOVUPS 48(SI), X3
+	MUL4COL(0)
+	RET
+
+// func mulMat4BatchSSE(dst, a, b []Mat4)
+TEXT ·mulMat4BatchSSE(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ a_base+24(FP), SI
+	MOVQ a_len+32(FP), CX
+	MOVQ b_base+48(FP), DX
+	TESTQ CX, CX
+	JZ   batchssedone
+
+batchsseloop:
+	MUL4SSE
+	ADDQ $64, SI
+	ADDQ $64, DX
+	ADDQ $64, DI
+	DECQ CX
+	JNZ  batchsseloop
+
+batchssedone:
+	RET
+
+// func mulMat4BatchAVX(dst, a, b []Mat4)
+TEXT ·mulMat4BatchAVX(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ a_base+24(FP), SI
+	MOVQ a_len+32(FP), CX
+	MOVQ b_base+48(FP), DX
+	TESTQ CX, CX
+	JZ   batchavxdone
+
+batchavxloop:
+	MUL4AVX
+	ADDQ $64, SI
+	ADDQ $64, DX
+	ADDQ $64, DI
+	DECQ CX
+	JNZ  batchavxloop
+
+	VZEROUPPER
+
+batchavxdone:
+	RET
+
+// TRANSFORM3 computes X0*x + X1*y + X2*z into X4 for the Vec3 at 0(SI). It
+// uses X5.
+#define TRANSFORM3 \
+	MOVSS 0(SI), X4; SHUFPS $0x00, X4, X4; MULPS X0, X4; \
+	MOVSS 4(SI), X5; SHUFPS $0x00, X5, X5; MULPS X1, X5; ADDPS X5, X4; \
+	MOVSS 8(SI), X5; SHUFPS $0x00, X5, X5; MULPS X2, X5; ADDPS X5, X4
+
+// STORE3 stores the first three elements of X4 at 0(DI), destroying X4.
+#define STORE3 \
+	MOVQ    X4, 0(DI); \
+	MOVHLPS X4, X4; \
+	MOVSS   X4, 8(DI)
+
+// func transformCoordinatesSSE(dst, src []Vec3, m *Mat4)
+TEXT ·transformCoordinatesSSE(SB), NOSPLIT, $0-56
+	MOVQ   dst_base+0(FP), DI
+	MOVQ   src_base+24(FP), SI
+	MOVQ   src_len+32(FP), CX
+	MOVQ   m+48(FP), DX
+	MOVUPS 0(DX), X0
+	MOVUPS 16(DX), X1
+	MOVUPS 32(DX), X2
+	MOVUPS 48(DX), X3
+	MOVUPS ones<>(SB), X7
+	TESTQ  CX, CX
+	JZ     coorddone
+
+coordloop:
+	TRANSFORM3
+	ADDPS  X3, X4
+
+	// Divide by w
+	MOVAPS X4, X5
+	SHUFPS $0xFF, X5, X5
+	MOVAPS X7, X6
+	DIVPS  X5, X6
+	MULPS  X6, X4
+
+	STORE3
+	ADDQ   $12, SI
+	ADDQ   $12, DI
+	DECQ   CX
+	JNZ    coordloop
+
+coorddone:
+	RET
+
+// func transformNormalsSSE(dst, src []Vec3, m *Mat4)
+TEXT ·transformNormalsSSE(SB), NOSPLIT, $0-56
+	MOVQ   dst_base+0(FP), DI
+	MOVQ   src_base+24(FP), SI
+	MOVQ   src_len+32(FP), CX
+	MOVQ   m+48(FP), DX
+	MOVUPS 0(DX), X0
+	MOVUPS 16(DX), X1
+	MOVUPS 32(DX), X2
+	TESTQ  CX, CX
+	JZ     normaldone
+
+normalloop:
+	TRANSFORM3
+	STORE3
+	ADDQ $12, SI
+	ADDQ $12, DI
+	DECQ CX
+	JNZ  normalloop
+
+normaldone:
+	RET
+
+// func normalizeAllSSE(vs []Vec3)
+TEXT ·normalizeAllSSE(SB), NOSPLIT, $0-24
+	MOVQ  vs_base+0(FP), SI
+	MOVQ  vs_len+8(FP), CX
+	MOVSS ones<>(SB), X7
+	TESTQ CX, CX
+	JZ    normalizedone
+
+normalizeloop:
+	MOVQ    0(SI), X0
+	MOVSS   8(SI), X1
+	MOVLHPS X1, X0
+
+	// The length, summing the squares in the same order as Vec3.Len
+	MOVAPS X0, X2
+	MULPS  X2, X2
+	MOVAPS X2, X4
+	MOVAPS X2, X3
+	SHUFPS $0x55, X3, X3
+	ADDSS  X3, X4
+	MOVAPS X2, X3
+	SHUFPS $0xAA, X3, X3
+	ADDSS  X3, X4
+	SQRTSS X4, X4
+
+	MOVAPS X7, X5
+	DIVSS  X4, X5
+	SHUFPS $0x00, X5, X5
+	MULPS  X5, X0
+
+	MOVQ    X0, 0(SI)
+	MOVHLPS X0, X0
+	MOVSS   X0, 8(SI)
+	ADDQ    $12, SI
+	DECQ    CX
+	JNZ     normalizeloop
+
+normalizedone:
+	RET
+
+// MINORS computes the 2x2 minors a[p]*b[q] - b[p]*a[q] into d, where the
+// indices p and q of every element are selected by the shuffles i1 and i2. It
+// uses X8-X10.
+#define MINORS(a, b, i1, i2, d) \
+	MOVAPS a, d; SHUFPS i1, d, d; \
+	MOVAPS b, X8; SHUFPS i2, X8, X8; \
+	MULPS  X8, d; \
+	MOVAPS b, X9; SHUFPS i1, X9, X9; \
+	MOVAPS a, X10; SHUFPS i2, X10, X10; \
+	MULPS  X10, X9; \
+	SUBPS  X9, d
+
+// func mat4InvSSE(dst, m *Mat4) float32
+//
+// This computes the adjugate from the 2x2 minors of the first two and the
+// last two columns (see Eberly, "The Laplace Expansion Theorem: Computing the
+// Determinants and Inverses of Matrices"). Treating the column-major matrix as
+// a row-major one gives its transpose, and the inverse of the transpose is the
+// transpose of the inverse, so in the comments below aij is element j of
+// column i, and the result is stored in the same way.
+TEXT ·mat4InvSSE(SB), NOSPLIT, $0-20
+	MOVQ   dst+0(FP), DI
+	MOVQ   m+8(FP), SI
+	MOVUPS 0(SI), X0
+	MOVUPS 16(SI), X1
+	MOVUPS 32(SI), X2
+	MOVUPS 48(SI), X3
+
+	// X4 = s0 s1 s2 s3, X5 = s4 s5 s4 s5 with sk = a0p*a1q - a1p*a0q for the
+	// pairs pq = 01 02 03 12 13 23. Likewise the ck in X6 and X7 for a2 and a3.
+	MINORS(X0, X1, $0x40, $0xB9, X4)
+	MINORS(X0, X1, $0x99, $0xFF, X5)
+	MINORS(X2, X3, $0x40, $0xB9, X6)
+	MINORS(X2, X3, $0x99, $0xFF, X7)
+
+	// Kk = ck ck sk sk in X13-k
+	MOVAPS X7, X8
+	SHUFPS $0x55, X5, X8
+	MOVAPS X7, X9
+	SHUFPS $0x00, X5, X9
+	MOVAPS X6, X10
+	SHUFPS $0xFF, X4, X10
+	MOVAPS X6, X11
+	SHUFPS $0xAA, X4, X11
+	MOVAPS X6, X12
+	SHUFPS $0x55, X4, X12
+	MOVAPS X6, X13
+	SHUFPS $0x00, X4, X13
+
+	// Transpose with the pairs of rows swapped, Vj = a1j a0j a3j a2j:
+	// V0 in X0, V1 in X5, V2 in X2 and V3 in X7
+	MOVAPS   X1, X4
+	UNPCKLPS X0, X4
+	MOVAPS   X3, X5
+	UNPCKLPS X2, X5
+	MOVAPS   X1, X6
+	UNPCKHPS X0, X6
+	MOVAPS   X3, X7
+	UNPCKHPS X2, X7
+	MOVAPS   X4, X0
+	MOVLHPS  X5, X0
+	MOVHLPS  X4, X5
+	MOVAPS   X6, X2
+	MOVLHPS  X7, X2
+	MOVHLPS  X6, X7
+
+	// Row 0 of the adjugate, (V1*K5 - V2*K4 + V3*K3) with odd elements negated
+	MOVAPS X5, X1
+	MULPS  X8, X1
+	MOVAPS X2, X3
+	MULPS  X9, X3
+	SUBPS  X3, X1
+	MOVAPS X7, X3
+	MULPS  X10, X3
+	ADDPS  X3, X1
+
+	// Row 1, (V0*K5 - V2*K2 + V3*K1) with even elements negated
+	MOVAPS X0, X3
+	MULPS  X8, X3
+	MOVAPS X2, X4
+	MULPS  X11, X4
+	SUBPS  X4, X3
+	MOVAPS X7, X4
+	MULPS  X12, X4
+	ADDPS  X4, X3
+
+	// Row 2, (V0*K4 - V1*K2 + V3*K0) with odd elements negated
+	MOVAPS X0, X4
+	MULPS  X9, X4
+	MOVAPS X5, X6
+	MULPS  X11, X6
+	SUBPS  X6, X4
+	MOVAPS X7, X6
+	MULPS  X13, X6
+	ADDPS  X6, X4
+
+	// Row 3, (V0*K3 - V1*K1 + V2*K0) with even elements negated
+	MOVAPS X0, X6
+	MULPS  X10, X6
+	MOVAPS X5, X8
+	MULPS  X12, X8
+	SUBPS  X8, X6
+	MOVAPS X2, X8
+	MULPS  X13, X8
+	ADDPS  X8, X6
+
+	MOVUPS signodd<>(SB), X14
+	XORPS  X14, X1
+	XORPS  X14, X4
+	MOVUPS signeven<>(SB), X15
+	XORPS  X15, X3
+	XORPS  X15, X6
+
+	// The determinant is the dot product of row 0 of the adjugate with
+	// column 0 of the matrix, a00 a10 a20 a30
+	MOVAPS  X0, X14
+	SHUFPS  $0xB1, X14, X14
+	MULPS   X1, X14
+	MOVHLPS X14, X15
+	ADDPS   X15, X14
+	MOVAPS  X14, X15
+	SHUFPS  $0x55, X15, X15
+	ADDSS   X15, X14
+	MOVSS   X14, ret+16(FP)
+
+	MOVSS  ones<>(SB), X15
+	DIVSS  X14, X15
+	SHUFPS $0x00, X15, X15
+	MULPS  X15, X1
+	MULPS  X15, X3
+	MULPS  X15, X4
+	MULPS  X15, X6
+	MOVUPS X1, 0(DI)
+	MOVUPS X3, 16(DI)
+	MOVUPS X4, 32(DI)
+	MOVUPS X6, 48(DI)
+	RET
@@ -0,0 +1,243 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && !purego
+// +build amd64,!purego
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func randMat4(r *rand.Rand) Mat4 {
+	var m Mat4
+	for i := range m {
+		m[i] = r.Float32()*20 - 10
+	}
+	return m
+}
+
+func TestMat4MulKernels(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		m1, m2 := randMat4(r), randMat4(r)
+		var expected, sse, avx Mat4
+
+		mat4Mul4Generic(&expected, &m1, &m2)
+		mat4Mul4SSE(&sse, &m1, &m2)
+		if !kernelEqual(sse[:], expected[:]) {
+			t.Fatalf("mat4Mul4SSE of %v and %v = %v, expected %v", m1, m2, sse, expected)
+		}
+		if hasAVX {
+			mat4Mul4AVX(&avx, &m1, &m2)
+			if !kernelEqual(avx[:], expected[:]) {
+				t.Fatalf("mat4Mul4AVX of %v and %v = %v, expected %v", m1, m2, avx, expected)
+			}
+		}
+
+		v := Vec4{r.Float32(), r.Float32(), r.Float32(), r.Float32()}
+		var expectedV, sseV Vec4
+		mat4Mul4x1Generic(&expectedV, &m1, &v)
+		mat4Mul4x1SSE(&sseV, &m1, &v)
+		if !kernelEqual(sseV[:], expectedV[:]) {
+			t.Fatalf("mat4Mul4x1SSE of %v and %v = %v, expected %v", m1, v, sseV, expectedV)
+		}
+	}
+
+	// In place
+	m1, m2 := randMat4(r), randMat4(r)
+	expected := m1.Mul4(m2)
+	mat4Mul4SSE(&m1, &m1, &m2)
+	if !kernelEqual(m1[:], expected[:]) {
+		t.Errorf("mat4Mul4SSE in place = %v, expected %v", m1, expected)
+	}
+}
+
+func TestMat4InvKernel(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 1000; i++ {
+		// A different formula gives different rounding errors, so stay clear of
+		// ill-conditioned matrices where those would be amplified
+		m := randMat4(r).Add(Ident4().Mul(40))
+		var expected, sse Mat4
+		expectedDet := mat4InvGeneric(&expected, &m)
+		det := mat4InvSSE(&sse, &m)
+
+		var size float32
+		for _, x := range expected {
+			size = float32(math.Max(float64(size), float64(Abs(x))))
+		}
+		tol := 1e-5 * size
+		if !FloatEqualThreshold(det, expectedDet, 1e-5) {
+			t.Fatalf("mat4InvSSE determinant of %v = %v, expected %v", m, det, expectedDet)
+		}
+		if !sse.ApproxFuncEqual(expected, func(a, b float32) bool { return Abs(a-b) <= tol }) {
+			t.Fatalf("mat4InvSSE of %v = %v, expected %v", m, sse, expected)
+		}
+	}
+
+	// Singular matrices still give a determinant of 0
+	m := Mat4{1, 2, 3, 4, 2, 4, 6, 8, 0, 1, 0, 1, 1, 0, 0, 1}
+	var dst Mat4
+	if det := mat4InvSSE(&dst, &m); det != 0 {
+		t.Errorf("mat4InvSSE determinant of singular %v = %v, expected 0", m, det)
+	}
+	if inv := m.Inv(); inv != (Mat4{}) {
+		t.Errorf("Inv of singular %v = %v, expected the zero matrix", m, inv)
+	}
+}
+
+func TestBatchKernels(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(3))
+	m := Perspective(DegToRad(60), 1.5, 0.1, 100).Mul4(randMat4(r))
+
+	// All lengths up to a few, to catch errors at the end of the loops
+	for n := 0; n < 20; n++ {
+		src := randVec3s(r, n)
+		expected, sse := make([]Vec3, n), make([]Vec3, n)
+
+		transformCoordinatesGeneric(expected, src, &m)
+		transformCoordinatesSSE(sse, src, &m)
+		if !vec3sClose(sse, expected) {
+			t.Fatalf("transformCoordinatesSSE of %v = %v, expected %v", src, sse, expected)
+		}
+
+		transformNormalsGeneric(expected, src, &m)
+		transformNormalsSSE(sse, src, &m)
+		if !vec3sClose(sse, expected) {
+			t.Fatalf("transformNormalsSSE of %v = %v, expected %v", src, sse, expected)
+		}
+
+		copy(expected, src)
+		copy(sse, src)
+		normalizeAllGeneric(expected)
+		normalizeAllSSE(sse)
+		if !vec3sClose(sse, expected) {
+			t.Fatalf("normalizeAllSSE of %v = %v, expected %v", src, sse, expected)
+		}
+
+		a, b := make([]Mat4, n), make([]Mat4, n)
+		for i := range a {
+			a[i], b[i] = randMat4(r), randMat4(r)
+		}
+		expectedM, sseM, avxM := make([]Mat4, n), make([]Mat4, n), make([]Mat4, n)
+		mulMat4BatchGeneric(expectedM, a, b)
+		mulMat4BatchSSE(sseM, a, b)
+		for i := range expectedM {
+			if !kernelEqual(sseM[i][:], expectedM[i][:]) {
+				t.Fatalf("mulMat4BatchSSE element %d = %v, expected %v", i, sseM[i], expectedM[i])
+			}
+		}
+		if hasAVX {
+			mulMat4BatchAVX(avxM, a, b)
+			for i := range expectedM {
+				if !kernelEqual(avxM[i][:], expectedM[i][:]) {
+					t.Fatalf("mulMat4BatchAVX element %d = %v, expected %v", i, avxM[i], expectedM[i])
+				}
+			}
+		}
+	}
+}
+
+// kernelEqual returns whether the results of a kernel match the expected ones
+// up to rounding errors, relative to the largest of them, treating NaNs as
+// equal. The pure Go versions may be compiled with fused multiply-adds, which
+// round differently.
+func kernelEqual(result, expected []float32) bool {
+	var size float32
+	for _, x := range expected {
+		if x == x && Abs(x) > size {
+			size = Abs(x)
+		}
+	}
+	tol := 1e-5 * size
+	for i := range expected {
+		a, b := result[i], expected[i]
+		if a != a || b != b {
+			if a == a || b == b {
+				return false
+			}
+			continue
+		}
+		if Abs(a-b) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+// vec3sClose returns whether the vectors match as by kernelEqual.
+func vec3sClose(v1, v2 []Vec3) bool {
+	if len(v1) != len(v2) {
+		return false
+	}
+	for i := range v1 {
+		if !kernelEqual(v1[i][:], v2[i][:]) {
+			return false
+		}
+	}
+	return true
+}
+
+func BenchmarkMat4Mul4Generic(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	m1, m2 := randMat4(r), randMat4(r)
+	var dst Mat4
+
+	for i := 0; i < b.N; i++ {
+		mat4Mul4Generic(&dst, &m1, &m2)
+	}
+}
+
+func BenchmarkMat4InvGeneric(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	m := randMat4(r)
+	var dst Mat4
+
+	for i := 0; i < b.N; i++ {
+		mat4InvGeneric(&dst, &m)
+	}
+}
+
+func BenchmarkMat4Mul4SSE(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	m1, m2 := randMat4(r), randMat4(r)
+	var dst Mat4
+
+	for i := 0; i < b.N; i++ {
+		mat4Mul4SSE(&dst, &m1, &m2)
+	}
+}
+
+func BenchmarkMat4Mul4AVX(b *testing.B) {
+	if !hasAVX {
+		b.Skip("AVX is not supported")
+	}
+	r := rand.New(rand.NewSource(1))
+	m1, m2 := randMat4(r), randMat4(r)
+	var dst Mat4
+
+	for i := 0; i < b.N; i++ {
+		mat4Mul4AVX(&dst, &m1, &m2)
+	}
+}
+
+func BenchmarkMat4InvSSE(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	m := randMat4(r)
+	var dst Mat4
+
+	for i := 0; i < b.N; i++ {
+		mat4InvSSE(&dst, &m)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 || purego
+// +build !amd64 purego
+
+package mgl32
+
+// On amd64, mgl32 implements these kernels in assembly (see simd_amd64.go).
+// Elsewhere, with the purego build tag, and in mgl64, they are the pure Go
+// versions.
+
+func mat4Mul4(dst, m1, m2 *Mat4) {
+	mat4Mul4Generic(dst, m1, m2)
+}
+
+func mat4Mul4x1(dst *Vec4, m1 *Mat4, m2 *Vec4) {
+	mat4Mul4x1Generic(dst, m1, m2)
+}
+
+func mat4Inv(dst, m *Mat4) float32 {
+	return mat4InvGeneric(dst, m)
+}
+
+func transformCoordinates(dst, src []Vec3, m *Mat4) {
+	transformCoordinatesGeneric(dst, src, m)
+}
+
+func transformNormals(dst, src []Vec3, m *Mat4) {
+	transformNormalsGeneric(dst, src, m)
+}
+
+func normalizeAll(vs []Vec3) {
+	normalizeAllGeneric(vs)
+}
+
+func mulMat4Batch(dst, a, b []Mat4) {
+	mulMat4BatchGeneric(dst, a, b)
+}
@@ -0,0 +1,458 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Sphere is a sphere in 3D space, or a bounding sphere. A sphere with a
+// negative radius is considered empty, while one with a radius of 0 contains
+// only its center.
+type Sphere struct {
+	Center Vec3
+	Radius float32
+}
+
+// Circle2D is a circle, or a bounding circle, in 2D space. A circle with a
+// negative radius is considered empty, while one with a radius of 0 contains
+// only its center.
+type Circle2D struct {
+	Center Vec2
+	Radius float32
+}
+
+// boundTolerance is the relative amount by which a point may lie outside of a
+// bounding sphere or circle while fitting it, to absorb the rounding error of
+// computing its center.
+var boundTolerance = 64 * machineEpsilon
+
+// SphereFromPoints returns the smallest sphere that contains all of points,
+// using Welzl's algorithm. The points are visited in a shuffled order, which
+// makes it run in expected linear time. The points slice itself is not
+// modified. If points is empty, the result is an empty sphere at the origin.
+func SphereFromPoints(points []Vec3) Sphere {
+	p := make([]Vec3, len(points))
+	copy(p, points)
+	rand.New(rand.NewSource(1)).Shuffle(len(p), func(i, j int) { p[i], p[j] = p[j], p[i] })
+
+	// This is the iterative form of the algorithm: each loop finds the
+	// smallest sphere of the points before it, with the points chosen by the
+	// enclosing loops on its surface
+	s := Sphere{Radius: -1}
+	for i := range p {
+		if s.bounds(p[i]) {
+			continue
+		}
+		s = Sphere{p[i], 0}
+		for j := 0; j < i; j++ {
+			if s.bounds(p[j]) {
+				continue
+			}
+			s = sphereFrom2(p[i], p[j])
+			for k := 0; k < j; k++ {
+				if s.bounds(p[k]) {
+					continue
+				}
+				s = sphereFrom3(p[i], p[j], p[k])
+				for l := 0; l < k; l++ {
+					if !s.bounds(p[l]) {
+						s = sphereFrom4(p[i], p[j], p[k], p[l])
+					}
+				}
+			}
+		}
+	}
+
+	return s
+}
+
+// Circle2DFromPoints returns the smallest circle that contains all of points,
+// using Welzl's algorithm. The points are visited in a shuffled order, which
+// makes it run in expected linear time. The points slice itself is not
+// modified. If points is empty, the result is an empty circle at the origin.
+func Circle2DFromPoints(points []Vec2) Circle2D {
+	p := make([]Vec2, len(points))
+	copy(p, points)
+	rand.New(rand.NewSource(1)).Shuffle(len(p), func(i, j int) { p[i], p[j] = p[j], p[i] })
+
+	c := Circle2D{Radius: -1}
+	for i := range p {
+		if c.bounds(p[i]) {
+			continue
+		}
+		c = Circle2D{p[i], 0}
+		for j := 0; j < i; j++ {
+			if c.bounds(p[j]) {
+				continue
+			}
+			c = circleFrom2(p[i], p[j])
+			for k := 0; k < j; k++ {
+				if !c.bounds(p[k]) {
+					c = circleFrom3(p[i], p[j], p[k])
+				}
+			}
+		}
+	}
+
+	return c
+}
+
+// SphereFromPointsRitter fits a sphere around points with Ritter's algorithm.
+// It starts from a sphere between two points that are far apart, and grows it
+// just enough to include every point that lies outside of it.
+//
+// This takes three passes over the points, two to find the starting points and
+// one to grow the sphere, and gives a sphere that is typically up to 5-20%
+// larger than the smallest one returned by SphereFromPoints. If points is
+// empty, the result is an empty sphere at the origin.
+func SphereFromPointsRitter(points []Vec3) Sphere {
+	if len(points) == 0 {
+		return Sphere{Radius: -1}
+	}
+
+	farthest := func(from Vec3) Vec3 {
+		best, bestDist := from, float32(-1)
+		for _, p := range points {
+			if d := p.Sub(from).LenSqr(); d > bestDist {
+				best, bestDist = p, d
+			}
+		}
+		return best
+	}
+	a := farthest(points[0])
+	s := sphereFrom2(a, farthest(a))
+
+	for _, p := range points {
+		if d := p.Sub(s.Center).Len(); d > s.Radius {
+			r := (s.Radius + d) / 2
+			s.Center = s.Center.Add(p.Sub(s.Center).Mul((r - s.Radius) / d))
+			s.Radius = r
+		}
+	}
+
+	return s
+}
+
+// Circle2DFromPointsRitter fits a circle around points with Ritter's
+// algorithm. It starts from a circle between two points that are far apart,
+// and grows it just enough to include every point that lies outside of it.
+//
+// This takes three passes over the points, two to find the starting points and
+// one to grow the circle, and gives a circle that is typically up to 5-20%
+// larger than the smallest one returned by Circle2DFromPoints. If points is
+// empty, the result is an empty circle at the origin.
+func Circle2DFromPointsRitter(points []Vec2) Circle2D {
+	if len(points) == 0 {
+		return Circle2D{Radius: -1}
+	}
+
+	farthest := func(from Vec2) Vec2 {
+		best, bestDist := from, float32(-1)
+		for _, p := range points {
+			if d := p.Sub(from).LenSqr(); d > bestDist {
+				best, bestDist = p, d
+			}
+		}
+		return best
+	}
+	a := farthest(points[0])
+	c := circleFrom2(a, farthest(a))
+
+	for _, p := range points {
+		if d := p.Sub(c.Center).Len(); d > c.Radius {
+			r := (c.Radius + d) / 2
+			c.Center = c.Center.Add(p.Sub(c.Center).Mul((r - c.Radius) / d))
+			c.Radius = r
+		}
+	}
+
+	return c
+}
+
+// sphereFrom2 returns the sphere with a and b on opposite sides.
+func sphereFrom2(a, b Vec3) Sphere {
+	return Sphere{a.Add(b).Mul(0.5), b.Sub(a).Len() / 2}
+}
+
+// circleFrom2 returns the circle with a and b on opposite sides.
+func circleFrom2(a, b Vec2) Circle2D {
+	return Circle2D{a.Add(b).Mul(0.5), b.Sub(a).Len() / 2}
+}
+
+// sphereFrom3 returns the smallest sphere with a, b and c on its surface,
+// which is centered on the plane of the triangle. If the points are
+// collinear, it returns the sphere between the two that are farthest apart.
+func sphereFrom3(a, b, c Vec3) Sphere {
+	ab, ac := b.Sub(a), c.Sub(a)
+	n := ab.Cross(ac)
+	nLenSqr := n.LenSqr()
+	if nLenSqr <= boundTolerance*ab.LenSqr()*ac.LenSqr() {
+		s := sphereFrom2(a, b)
+		if s2 := sphereFrom2(a, c); s2.Radius > s.Radius {
+			s = s2
+		}
+		if s2 := sphereFrom2(b, c); s2.Radius > s.Radius {
+			s = s2
+		}
+		return s
+	}
+
+	offset := n.Cross(ab).Mul(ac.LenSqr()).Add(ac.Cross(n).Mul(ab.LenSqr())).Mul(1 / (2 * nLenSqr))
+	return Sphere{a.Add(offset), offset.Len()}
+}
+
+// circleFrom3 returns the circle through a, b and c. If the points are
+// collinear, it returns the circle between the two that are farthest apart.
+func circleFrom3(a, b, c Vec2) Circle2D {
+	ab, ac := b.Sub(a), c.Sub(a)
+	abLenSqr, acLenSqr := ab.LenSqr(), ac.LenSqr()
+	d := 2 * (ab[0]*ac[1] - ab[1]*ac[0])
+	if d*d <= 4*boundTolerance*abLenSqr*acLenSqr {
+		c1 := circleFrom2(a, b)
+		if c2 := circleFrom2(a, c); c2.Radius > c1.Radius {
+			c1 = c2
+		}
+		if c2 := circleFrom2(b, c); c2.Radius > c1.Radius {
+			c1 = c2
+		}
+		return c1
+	}
+
+	offset := Vec2{
+		(ac[1]*abLenSqr - ab[1]*acLenSqr) / d,
+		(ab[0]*acLenSqr - ac[0]*abLenSqr) / d,
+	}
+	return Circle2D{a.Add(offset), offset.Len()}
+}
+
+// sphereFrom4 returns the sphere through a, b, c and d. If the points are
+// coplanar, it returns the smallest sphere through three of them that contains
+// the fourth one.
+func sphereFrom4(a, b, c, d Vec3) Sphere {
+	ab, ac, ad := b.Sub(a), c.Sub(a), d.Sub(a)
+	det := ab.Dot(ac.Cross(ad))
+	if det*det <= boundTolerance*ab.LenSqr()*ac.LenSqr()*ad.LenSqr() {
+		points := [4]Vec3{a, b, c, d}
+		best := Sphere{Radius: -1}
+		for i := range points {
+			others := [3]Vec3{}
+			copy(others[:], points[:i])
+			copy(others[i:], points[i+1:])
+			s := sphereFrom3(others[0], others[1], others[2])
+			if s.bounds(points[i]) && (best.IsEmpty() || s.Radius < best.Radius) {
+				best = s
+			}
+		}
+		if best.IsEmpty() {
+			best = sphereFrom3(a, b, c).Merge(Sphere{d, 0})
+		}
+		return best
+	}
+
+	offset := ac.Cross(ad).Mul(ab.LenSqr()).
+		Add(ad.Cross(ab).Mul(ac.LenSqr())).
+		Add(ab.Cross(ac).Mul(ad.LenSqr())).
+		Mul(1 / (2 * det))
+	return Sphere{a.Add(offset), offset.Len()}
+}
+
+// bounds checks if p is within the sphere, allowing for rounding errors.
+func (s1 Sphere) bounds(p Vec3) bool {
+	return p.Sub(s1.Center).LenSqr() <= s1.Radius*s1.Radius*(1+boundTolerance) && s1.Radius >= 0
+}
+
+// bounds checks if p is within the circle, allowing for rounding errors.
+func (c1 Circle2D) bounds(p Vec2) bool {
+	return p.Sub(c1.Center).LenSqr() <= c1.Radius*c1.Radius*(1+boundTolerance) && c1.Radius >= 0
+}
+
+// IsEmpty checks if the sphere is empty, that is, if its radius is negative.
+func (s1 Sphere) IsEmpty() bool {
+	return s1.Radius < 0
+}
+
+// IsEmpty checks if the circle is empty, that is, if its radius is negative.
+func (c1 Circle2D) IsEmpty() bool {
+	return c1.Radius < 0
+}
+
+// Volume returns the volume of the sphere, or 0 if it is empty.
+func (s1 Sphere) Volume() float32 {
+	if s1.IsEmpty() {
+		return 0
+	}
+	return 4 * math.Pi / 3 * s1.Radius * s1.Radius * s1.Radius
+}
+
+// Area returns the area of the circle, or 0 if it is empty.
+func (c1 Circle2D) Area() float32 {
+	if c1.IsEmpty() {
+		return 0
+	}
+	return math.Pi * c1.Radius * c1.Radius
+}
+
+// AABB returns the axis-aligned box around the sphere.
+func (s1 Sphere) AABB() AABB3 {
+	if s1.IsEmpty() {
+		return EmptyAABB3()
+	}
+	r := Vec3{s1.Radius, s1.Radius, s1.Radius}
+	return AABB3{s1.Center.Sub(r), s1.Center.Add(r)}
+}
+
+// AABB returns the axis-aligned box around the circle.
+func (c1 Circle2D) AABB() AABB2 {
+	if c1.IsEmpty() {
+		return EmptyAABB2()
+	}
+	r := Vec2{c1.Radius, c1.Radius}
+	return AABB2{c1.Center.Sub(r), c1.Center.Add(r)}
+}
+
+// ContainsPoint checks if p is inside the sphere or on its surface.
+func (s1 Sphere) ContainsPoint(p Vec3) bool {
+	return !s1.IsEmpty() && p.Sub(s1.Center).LenSqr() <= s1.Radius*s1.Radius
+}
+
+// ContainsPoint checks if p is inside the circle or on its boundary.
+func (c1 Circle2D) ContainsPoint(p Vec2) bool {
+	return !c1.IsEmpty() && p.Sub(c1.Center).LenSqr() <= c1.Radius*c1.Radius
+}
+
+// Intersects checks if the spheres overlap or touch. Empty spheres don't
+// intersect anything.
+func (s1 Sphere) Intersects(s2 Sphere) bool {
+	if s1.IsEmpty() || s2.IsEmpty() {
+		return false
+	}
+	r := s1.Radius + s2.Radius
+	return s2.Center.Sub(s1.Center).LenSqr() <= r*r
+}
+
+// Intersects checks if the circles overlap or touch. Empty circles don't
+// intersect anything.
+func (c1 Circle2D) Intersects(c2 Circle2D) bool {
+	if c1.IsEmpty() || c2.IsEmpty() {
+		return false
+	}
+	r := c1.Radius + c2.Radius
+	return c2.Center.Sub(c1.Center).LenSqr() <= r*r
+}
+
+// IntersectRay intersects the ray r with the sphere, see Ray3.IntersectSphere.
+// An empty sphere is never hit.
+func (s1 Sphere) IntersectRay(r Ray3) (t float32, ok bool) {
+	if s1.IsEmpty() {
+		return 0, false
+	}
+	return r.IntersectSphere(s1.Center, s1.Radius)
+}
+
+// Support returns the point of the sphere that is farthest in the direction
+// dir, which makes Sphere a Shape. If dir is zero, it returns the center.
+func (s1 Sphere) Support(dir Vec3) Vec3 {
+	l := dir.Len()
+	if l == 0 {
+		return s1.Center
+	}
+	return s1.Center.Add(dir.Mul(s1.Radius / l))
+}
+
+// Merge returns the smallest sphere that contains both spheres.
+func (s1 Sphere) Merge(s2 Sphere) Sphere {
+	if s1.IsEmpty() {
+		return s2
+	}
+	if s2.IsEmpty() {
+		return s1
+	}
+
+	d := s2.Center.Sub(s1.Center)
+	dist := d.Len()
+	if dist+s2.Radius <= s1.Radius {
+		return s1
+	}
+	if dist+s1.Radius <= s2.Radius {
+		return s2
+	}
+
+	r := (dist + s1.Radius + s2.Radius) / 2
+	return Sphere{s1.Center.Add(d.Mul((r - s1.Radius) / dist)), r}
+}
+
+// Merge returns the smallest circle that contains both circles.
+func (c1 Circle2D) Merge(c2 Circle2D) Circle2D {
+	if c1.IsEmpty() {
+		return c2
+	}
+	if c2.IsEmpty() {
+		return c1
+	}
+
+	d := c2.Center.Sub(c1.Center)
+	dist := d.Len()
+	if dist+c2.Radius <= c1.Radius {
+		return c1
+	}
+	if dist+c1.Radius <= c2.Radius {
+		return c2
+	}
+
+	r := (dist + c1.Radius + c2.Radius) / 2
+	return Circle2D{c1.Center.Add(d.Mul((r - c1.Radius) / dist)), r}
+}
+
+// Transform returns a sphere that contains the sphere transformed by the
+// affine homogeneous matrix m. The radius is scaled by the largest scale of m,
+// see ExtractMaxScale, so the result is exact for uniform scales and
+// conservative otherwise.
+func (s1 Sphere) Transform(m Mat4) Sphere {
+	if s1.IsEmpty() {
+		return s1
+	}
+	return Sphere{TransformCoordinate(s1.Center, m), s1.Radius * ExtractMaxScale(m)}
+}
+
+// Transform returns a circle that contains the circle transformed by the
+// affine 2D homogeneous matrix m. The radius is scaled by the largest scale of
+// m, so the result is exact for uniform scales and conservative otherwise.
+func (c1 Circle2D) Transform(m Mat3) Circle2D {
+	if c1.IsEmpty() {
+		return c1
+	}
+	scaleX := float64(m[0]*m[0] + m[1]*m[1])
+	scaleY := float64(m[3]*m[3] + m[4]*m[4])
+	return Circle2D{
+		m.Mul3x1(c1.Center.Vec3(1)).Vec2(),
+		c1.Radius * float32(math.Sqrt(math.Max(scaleX, scaleY))),
+	}
+}
+
+// ApproxEqual checks if the spheres are approximately equal, see
+// FloatEqual.
+func (s1 Sphere) ApproxEqual(s2 Sphere) bool {
+	return s1.Center.ApproxEqual(s2.Center) && FloatEqual(s1.Radius, s2.Radius)
+}
+
+// ApproxEqualThreshold checks if the spheres are approximately equal with
+// the given epsilon, see FloatEqualThreshold.
+func (s1 Sphere) ApproxEqualThreshold(s2 Sphere, epsilon float32) bool {
+	return s1.Center.ApproxEqualThreshold(s2.Center, epsilon) && FloatEqualThreshold(s1.Radius, s2.Radius, epsilon)
+}
+
+// ApproxEqual checks if the circles are approximately equal, see
+// FloatEqual.
+func (c1 Circle2D) ApproxEqual(c2 Circle2D) bool {
+	return c1.Center.ApproxEqual(c2.Center) && FloatEqual(c1.Radius, c2.Radius)
+}
+
+// ApproxEqualThreshold checks if the circles are approximately equal with
+// the given epsilon, see FloatEqualThreshold.
+func (c1 Circle2D) ApproxEqualThreshold(c2 Circle2D, epsilon float32) bool {
+	return c1.Center.ApproxEqualThreshold(c2.Center, epsilon) && FloatEqualThreshold(c1.Radius, c2.Radius, epsilon)
+}
@@ -0,0 +1,246 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// bruteForceSphere returns the smallest of the spheres through two, three or
+// four of points that contains all of them.
+func bruteForceSphere(points []Vec3) Sphere {
+	best := Sphere{Radius: -1}
+	try := func(s Sphere) {
+		for _, p := range points {
+			if !s.bounds(p) {
+				return
+			}
+		}
+		if best.IsEmpty() || s.Radius < best.Radius {
+			best = s
+		}
+	}
+	for i := range points {
+		for j := 0; j < i; j++ {
+			try(sphereFrom2(points[i], points[j]))
+			for k := 0; k < j; k++ {
+				try(sphereFrom3(points[i], points[j], points[k]))
+				for l := 0; l < k; l++ {
+					try(sphereFrom4(points[i], points[j], points[k], points[l]))
+				}
+			}
+		}
+	}
+	return best
+}
+
+func TestSphereFromPoints(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	for n := 2; n < 12; n++ {
+		points := randVec3s(r, n)
+		s := SphereFromPoints(points)
+		if expected := bruteForceSphere(points); !s.ApproxEqualThreshold(expected, 1e-4) {
+			t.Errorf("SphereFromPoints(%v) = %v, expected %v", points, s, expected)
+		}
+	}
+
+	for _, n := range []int{10, 100, 10000} {
+		points := randVec3s(r, n)
+		s, ritter := SphereFromPoints(points), SphereFromPointsRitter(points)
+		for _, p := range points {
+			if p.Sub(s.Center).Len() > s.Radius*(1+1e-4) {
+				t.Fatalf("SphereFromPoints sphere %v doesn't contain %v", s, p)
+			}
+			if p.Sub(ritter.Center).Len() > ritter.Radius*(1+1e-4) {
+				t.Fatalf("SphereFromPointsRitter sphere %v doesn't contain %v", ritter, p)
+			}
+		}
+		if ritter.Radius < s.Radius*(1-1e-4) {
+			t.Errorf("SphereFromPointsRitter radius %v is smaller than the minimum %v", ritter.Radius, s.Radius)
+		}
+	}
+
+	// Degenerate inputs: repeated, collinear and coplanar points
+	tests := []struct {
+		Points []Vec3
+		Sphere Sphere
+	}{
+		{[]Vec3{{1, 2, 3}}, Sphere{Vec3{1, 2, 3}, 0}},
+		{[]Vec3{{1, 2, 3}, {1, 2, 3}, {1, 2, 3}}, Sphere{Vec3{1, 2, 3}, 0}},
+		{[]Vec3{{0, 0, 0}, {1, 0, 0}, {2, 0, 0}, {4, 0, 0}}, Sphere{Vec3{2, 0, 0}, 2}},
+		{[]Vec3{{-1, -1, 0}, {1, -1, 0}, {1, 1, 0}, {-1, 1, 0}, {0, 0, 0}}, Sphere{Vec3{}, math.Sqrt2}},
+		{[]Vec3{{1, 0, 0}, {-1, 0, 0}, {0, 1, 0}, {0, -1, 0}, {0, 0, 1}, {0, 0, -1}}, Sphere{Vec3{}, 1}},
+	}
+	for _, test := range tests {
+		if s := SphereFromPoints(test.Points); !s.ApproxEqualThreshold(test.Sphere, 1e-5) {
+			t.Errorf("SphereFromPoints(%v) = %v, expected %v", test.Points, s, test.Sphere)
+		}
+	}
+
+	if s := SphereFromPoints(nil); !s.IsEmpty() {
+		t.Errorf("SphereFromPoints(nil) = %v, expected an empty sphere", s)
+	}
+	if s := SphereFromPointsRitter(nil); !s.IsEmpty() {
+		t.Errorf("SphereFromPointsRitter(nil) = %v, expected an empty sphere", s)
+	}
+}
+
+func TestCircle2DFromPoints(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(2))
+	for n := 2; n < 12; n++ {
+		points := make([]Vec2, n)
+		for i := range points {
+			points[i] = Vec2{r.Float32()*2 - 1, r.Float32()*2 - 1}
+		}
+
+		// Brute force over the circles through two or three of the points
+		expected := Circle2D{Radius: -1}
+		try := func(c Circle2D) {
+			for _, p := range points {
+				if !c.bounds(p) {
+					return
+				}
+			}
+			if expected.IsEmpty() || c.Radius < expected.Radius {
+				expected = c
+			}
+		}
+		for i := range points {
+			for j := 0; j < i; j++ {
+				try(circleFrom2(points[i], points[j]))
+				for k := 0; k < j; k++ {
+					try(circleFrom3(points[i], points[j], points[k]))
+				}
+			}
+		}
+
+		c, ritter := Circle2DFromPoints(points), Circle2DFromPointsRitter(points)
+		if !c.ApproxEqualThreshold(expected, 1e-4) {
+			t.Errorf("Circle2DFromPoints(%v) = %v, expected %v", points, c, expected)
+		}
+		for _, p := range points {
+			if p.Sub(ritter.Center).Len() > ritter.Radius*(1+1e-4) {
+				t.Errorf("Circle2DFromPointsRitter circle %v doesn't contain %v", ritter, p)
+			}
+		}
+	}
+
+	square := []Vec2{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {1, 1}, {1, 0}}
+	if c := Circle2DFromPoints(square); !c.ApproxEqualThreshold(Circle2D{Vec2{1, 1}, math.Sqrt2}, 1e-5) {
+		t.Errorf("Circle2DFromPoints(%v) = %v", square, c)
+	}
+	line := []Vec2{{0, 0}, {1, 1}, {3, 3}, {2, 2}}
+	if c := Circle2DFromPoints(line); !c.ApproxEqualThreshold(Circle2D{Vec2{1.5, 1.5}, 1.5 * math.Sqrt2}, 1e-5) {
+		t.Errorf("Circle2DFromPoints(%v) = %v", line, c)
+	}
+	if c := Circle2DFromPoints(nil); !c.IsEmpty() {
+		t.Errorf("Circle2DFromPoints(nil) = %v, expected an empty circle", c)
+	}
+}
+
+func TestSphereMerge(t *testing.T) {
+	t.Parallel()
+
+	empty := Sphere{Radius: -1}
+	tests := []struct {
+		S1, S2, Merged Sphere
+	}{
+		{Sphere{Vec3{0, 0, 0}, 1}, Sphere{Vec3{4, 0, 0}, 1}, Sphere{Vec3{2, 0, 0}, 3}},
+		{Sphere{Vec3{0, 0, 0}, 3}, Sphere{Vec3{1, 0, 0}, 1}, Sphere{Vec3{0, 0, 0}, 3}},
+		{Sphere{Vec3{0, 0, 0}, 1}, Sphere{Vec3{0, 3, 0}, 2}, Sphere{Vec3{0, 2, 0}, 3}},
+		{Sphere{Vec3{1, 2, 3}, 1}, empty, Sphere{Vec3{1, 2, 3}, 1}},
+	}
+	for _, test := range tests {
+		if s := test.S1.Merge(test.S2); !s.ApproxEqual(test.Merged) {
+			t.Errorf("%v.Merge(%v) = %v, expected %v", test.S1, test.S2, s, test.Merged)
+		}
+		if s := test.S2.Merge(test.S1); !s.ApproxEqual(test.Merged) {
+			t.Errorf("%v.Merge(%v) = %v, expected %v", test.S2, test.S1, s, test.Merged)
+		}
+	}
+
+	c := Circle2D{Vec2{0, 0}, 1}.Merge(Circle2D{Vec2{0, 4}, 1})
+	if !c.ApproxEqual(Circle2D{Vec2{0, 2}, 3}) {
+		t.Errorf("Circle2D Merge got %v", c)
+	}
+}
+
+func TestSphereTransform(t *testing.T) {
+	t.Parallel()
+
+	s := Sphere{Vec3{1, 0, 0}, 2}
+	m := Translate3D(0, 5, 0).Mul4(HomogRotate3DZ(math.Pi / 2)).Mul4(Scale3D(1, 3, 2))
+	if got := s.Transform(m); !got.Center.ApproxFuncEqual(Vec3{0, 6, 0}, within(1e-5)) || !FloatEqual(got.Radius, 6) {
+		t.Errorf("%v.Transform(%v) = %v", s, m, got)
+	}
+	if got := (Sphere{Radius: -1}).Transform(Scale3D(0, 0, 0)); !got.IsEmpty() {
+		t.Errorf("Transform of an empty sphere = %v", got)
+	}
+
+	c := Circle2D{Vec2{1, 0}, 2}
+	m2 := Translate2D(0, 5).Mul3(HomogRotate2D(math.Pi / 2)).Mul3(Scale2D(3, 1))
+	if got := c.Transform(m2); !got.Center.ApproxFuncEqual(Vec2{0, 8}, within(1e-5)) || !FloatEqual(got.Radius, 6) {
+		t.Errorf("%v.Transform(%v) = %v", c, m2, got)
+	}
+}
+
+func TestSphereIntersects(t *testing.T) {
+	t.Parallel()
+
+	unit := Sphere{Vec3{}, 1}
+	tests := []struct {
+		S1, S2 Sphere
+		Result bool
+	}{
+		{unit, unit, true},
+		{unit, Sphere{Vec3{2, 0, 0}, 1}, true},
+		{unit, Sphere{Vec3{1.5, 1.5, 0}, 1}, false},
+		{unit, Sphere{Vec3{0, 0, 0.5}, 0}, true},
+		{unit, Sphere{Vec3{}, -1}, false},
+	}
+	for _, test := range tests {
+		if test.S1.Intersects(test.S2) != test.Result || test.S2.Intersects(test.S1) != test.Result {
+			t.Errorf("%v.Intersects(%v) != %v", test.S1, test.S2, test.Result)
+		}
+	}
+
+	if !(Circle2D{Vec2{}, 1}).Intersects(Circle2D{Vec2{1, 1}, 0.5}) || (Circle2D{Vec2{}, 1}).Intersects(Circle2D{Vec2{2, 2}, 1}) {
+		t.Errorf("Circle2D Intersects got the wrong result")
+	}
+	if !(Circle2D{Vec2{1, 1}, 1}).ContainsPoint(Vec2{1.5, 1.5}) || (Circle2D{Vec2{1, 1}, 1}).ContainsPoint(Vec2{0, 0}) {
+		t.Errorf("Circle2D ContainsPoint got the wrong result")
+	}
+
+	s := Sphere{Vec3{0, 0, 5}, 1}
+	rays := []struct {
+		Ray Ray3
+		T   float32
+		Ok  bool
+	}{
+		{Ray3{Vec3{}, Vec3{0, 0, 1}}, 4, true},
+		{Ray3{Vec3{0, 0, 5}, Vec3{0, 0, 1}}, 1, true},
+		{Ray3{Vec3{}, Vec3{0, 0, -1}}, 0, false},
+		{Ray3{Vec3{0, 2, 0}, Vec3{0, 0, 1}}, 0, false},
+	}
+	for _, test := range rays {
+		tHit, ok := s.IntersectRay(test.Ray)
+		if ok != test.Ok || (ok && !FloatEqualThreshold(tHit, test.T, 1e-5)) {
+			t.Errorf("%v.IntersectRay(%v) = %v, %v, expected %v, %v", s, test.Ray, tHit, ok, test.T, test.Ok)
+		}
+	}
+	if _, ok := (Sphere{Radius: -1}).IntersectRay(Ray3{Vec3{}, Vec3{0, 0, 1}}); ok {
+		t.Errorf("IntersectRay hit an empty sphere")
+	}
+
+	if b := s.AABB(); !b.ApproxEqual(AABB3{Vec3{-1, -1, 4}, Vec3{1, 1, 6}}) {
+		t.Errorf("%v.AABB() = %v", s, b)
+	}
+}
@@ -0,0 +1,433 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"sort"
+)
+
+// HermiteCurve2D interpolates the point t on the cubic Hermite curve that
+// starts at p1 with the tangent m1 and ends at p2 with the tangent m2. It
+// panics if t is not in the range [0,1].
+func HermiteCurve2D(t float32, p1, m1, p2, m2 Vec2) Vec2 {
+	if t < 0.0 || t > 1.0 {
+		panic("Can't interpolate on hermite curve with t out of range [0.0,1.0]")
+	}
+
+	t2, t3 := t*t, t*t*t
+	return p1.Mul(2*t3 - 3*t2 + 1).Add(m1.Mul(t3 - 2*t2 + t)).Add(p2.Mul(-2*t3 + 3*t2)).Add(m2.Mul(t3 - t2))
+}
+
+// HermiteCurve3D interpolates the point t on the cubic Hermite curve that
+// starts at p1 with the tangent m1 and ends at p2 with the tangent m2. It
+// panics if t is not in the range [0,1].
+func HermiteCurve3D(t float32, p1, m1, p2, m2 Vec3) Vec3 {
+	if t < 0.0 || t > 1.0 {
+		panic("Can't interpolate on hermite curve with t out of range [0.0,1.0]")
+	}
+
+	t2, t3 := t*t, t*t*t
+	return p1.Mul(2*t3 - 3*t2 + 1).Add(m1.Mul(t3 - 2*t2 + t)).Add(p2.Mul(-2*t3 + 3*t2)).Add(m2.Mul(t3 - t2))
+}
+
+// HermiteCurveDerivative2D returns the derivative with respect to t of the
+// curve of HermiteCurve2D, that is, its tangent at t.
+func HermiteCurveDerivative2D(t float32, p1, m1, p2, m2 Vec2) Vec2 {
+	if t < 0.0 || t > 1.0 {
+		panic("Can't interpolate on hermite curve with t out of range [0.0,1.0]")
+	}
+
+	t2 := t * t
+	return p1.Mul(6*t2 - 6*t).Add(m1.Mul(3*t2 - 4*t + 1)).Add(p2.Mul(-6*t2 + 6*t)).Add(m2.Mul(3*t2 - 2*t))
+}
+
+// HermiteCurveDerivative3D returns the derivative with respect to t of the
+// curve of HermiteCurve3D, that is, its tangent at t.
+func HermiteCurveDerivative3D(t float32, p1, m1, p2, m2 Vec3) Vec3 {
+	if t < 0.0 || t > 1.0 {
+		panic("Can't interpolate on hermite curve with t out of range [0.0,1.0]")
+	}
+
+	t2 := t * t
+	return p1.Mul(6*t2 - 6*t).Add(m1.Mul(3*t2 - 4*t + 1)).Add(p2.Mul(-6*t2 + 6*t)).Add(m2.Mul(3*t2 - 2*t))
+}
+
+// HermiteSpline2D interpolates the point t on the cubic Hermite spline that
+// passes through points[i] at the parameter knots[i] with the tangent
+// tangents[i]. The knots must be increasing, the three slices must have the
+// same length of at least 2, and t must be in the range [knots[0],
+// knots[len(knots)-1]], or this function will panic.
+func HermiteSpline2D(t float32, knots []float32, points, tangents []Vec2) Vec2 {
+	i, u, dt := hermiteSegment(t, knots, len(points), len(tangents))
+	return HermiteCurve2D(u, points[i], tangents[i].Mul(dt), points[i+1], tangents[i+1].Mul(dt))
+}
+
+// HermiteSpline3D interpolates the point t on the cubic Hermite spline that
+// passes through points[i] at the parameter knots[i] with the tangent
+// tangents[i]. The knots must be increasing, the three slices must have the
+// same length of at least 2, and t must be in the range [knots[0],
+// knots[len(knots)-1]], or this function will panic.
+func HermiteSpline3D(t float32, knots []float32, points, tangents []Vec3) Vec3 {
+	i, u, dt := hermiteSegment(t, knots, len(points), len(tangents))
+	return HermiteCurve3D(u, points[i], tangents[i].Mul(dt), points[i+1], tangents[i+1].Mul(dt))
+}
+
+// HermiteSplineDerivative2D returns the derivative with respect to t of the
+// spline of HermiteSpline2D.
+func HermiteSplineDerivative2D(t float32, knots []float32, points, tangents []Vec2) Vec2 {
+	i, u, dt := hermiteSegment(t, knots, len(points), len(tangents))
+	return HermiteCurveDerivative2D(u, points[i], tangents[i].Mul(dt), points[i+1], tangents[i+1].Mul(dt)).Mul(1 / dt)
+}
+
+// HermiteSplineDerivative3D returns the derivative with respect to t of the
+// spline of HermiteSpline3D.
+func HermiteSplineDerivative3D(t float32, knots []float32, points, tangents []Vec3) Vec3 {
+	i, u, dt := hermiteSegment(t, knots, len(points), len(tangents))
+	return HermiteCurveDerivative3D(u, points[i], tangents[i].Mul(dt), points[i+1], tangents[i+1].Mul(dt)).Mul(1 / dt)
+}
+
+// MakeHermiteSpline2D samples numPoints points evenly spaced in the parameter
+// along the spline of HermiteSpline2D, including both of its ends.
+func MakeHermiteSpline2D(numPoints int, knots []float32, points, tangents []Vec2) []Vec2 {
+	line := make([]Vec2, numPoints)
+	for i := range line {
+		line[i] = HermiteSpline2D(splineSample(i, numPoints, knots[0], knots[len(knots)-1]), knots, points, tangents)
+	}
+	return line
+}
+
+// MakeHermiteSpline3D samples numPoints points evenly spaced in the parameter
+// along the spline of HermiteSpline3D, including both of its ends.
+func MakeHermiteSpline3D(numPoints int, knots []float32, points, tangents []Vec3) []Vec3 {
+	line := make([]Vec3, numPoints)
+	for i := range line {
+		line[i] = HermiteSpline3D(splineSample(i, numPoints, knots[0], knots[len(knots)-1]), knots, points, tangents)
+	}
+	return line
+}
+
+// CatmullRomCurve2D interpolates the point t on the centripetal Catmull-Rom
+// curve between cPoint2 and cPoint3, which uses cPoint1 and cPoint4 to shape
+// its tangents. Unlike the uniform variant, the centripetal curve never forms
+// cusps or loops within a segment. It panics if t is not in the range [0,1].
+func CatmullRomCurve2D(t float32, cPoint1, cPoint2, cPoint3, cPoint4 Vec2) Vec2 {
+	m1, m2 := catmullRomTangents2D(cPoint1, cPoint2, cPoint3, cPoint4)
+	return HermiteCurve2D(t, cPoint2, m1, cPoint3, m2)
+}
+
+// CatmullRomCurve3D interpolates the point t on the centripetal Catmull-Rom
+// curve between cPoint2 and cPoint3, which uses cPoint1 and cPoint4 to shape
+// its tangents. Unlike the uniform variant, the centripetal curve never forms
+// cusps or loops within a segment. It panics if t is not in the range [0,1].
+func CatmullRomCurve3D(t float32, cPoint1, cPoint2, cPoint3, cPoint4 Vec3) Vec3 {
+	m1, m2 := catmullRomTangents3D(cPoint1, cPoint2, cPoint3, cPoint4)
+	return HermiteCurve3D(t, cPoint2, m1, cPoint3, m2)
+}
+
+// CatmullRomCurveDerivative2D returns the derivative with respect to t of the
+// curve of CatmullRomCurve2D.
+func CatmullRomCurveDerivative2D(t float32, cPoint1, cPoint2, cPoint3, cPoint4 Vec2) Vec2 {
+	m1, m2 := catmullRomTangents2D(cPoint1, cPoint2, cPoint3, cPoint4)
+	return HermiteCurveDerivative2D(t, cPoint2, m1, cPoint3, m2)
+}
+
+// CatmullRomCurveDerivative3D returns the derivative with respect to t of the
+// curve of CatmullRomCurve3D.
+func CatmullRomCurveDerivative3D(t float32, cPoint1, cPoint2, cPoint3, cPoint4 Vec3) Vec3 {
+	m1, m2 := catmullRomTangents3D(cPoint1, cPoint2, cPoint3, cPoint4)
+	return HermiteCurveDerivative3D(t, cPoint2, m1, cPoint3, m2)
+}
+
+// catmullRomTangents2D returns the tangents at cPoint2 and cPoint3 of the
+// centripetal Catmull-Rom curve between them, scaled for t in [0,1].
+func catmullRomTangents2D(cPoint1, cPoint2, cPoint3, cPoint4 Vec2) (m1, m2 Vec2) {
+	d1 := catmullRomInterval(cPoint2.Sub(cPoint1).Len())
+	d2 := catmullRomInterval(cPoint3.Sub(cPoint2).Len())
+	d3 := catmullRomInterval(cPoint4.Sub(cPoint3).Len())
+
+	m1 = cPoint2.Sub(cPoint1).Mul(1 / d1).Sub(cPoint3.Sub(cPoint1).Mul(1 / (d1 + d2))).Add(cPoint3.Sub(cPoint2).Mul(1 / d2))
+	m2 = cPoint3.Sub(cPoint2).Mul(1 / d2).Sub(cPoint4.Sub(cPoint2).Mul(1 / (d2 + d3))).Add(cPoint4.Sub(cPoint3).Mul(1 / d3))
+	return m1.Mul(d2), m2.Mul(d2)
+}
+
+// catmullRomTangents3D returns the tangents at cPoint2 and cPoint3 of the
+// centripetal Catmull-Rom curve between them, scaled for t in [0,1].
+func catmullRomTangents3D(cPoint1, cPoint2, cPoint3, cPoint4 Vec3) (m1, m2 Vec3) {
+	d1 := catmullRomInterval(cPoint2.Sub(cPoint1).Len())
+	d2 := catmullRomInterval(cPoint3.Sub(cPoint2).Len())
+	d3 := catmullRomInterval(cPoint4.Sub(cPoint3).Len())
+
+	m1 = cPoint2.Sub(cPoint1).Mul(1 / d1).Sub(cPoint3.Sub(cPoint1).Mul(1 / (d1 + d2))).Add(cPoint3.Sub(cPoint2).Mul(1 / d2))
+	m2 = cPoint3.Sub(cPoint2).Mul(1 / d2).Sub(cPoint4.Sub(cPoint2).Mul(1 / (d2 + d3))).Add(cPoint4.Sub(cPoint3).Mul(1 / d3))
+	return m1.Mul(d2), m2.Mul(d2)
+}
+
+// CatmullRomSpline2D interpolates the point t on the centripetal Catmull-Rom
+// spline through cPoints[1] to cPoints[len(cPoints)-2]. The first and last
+// control points only shape the tangents at the ends. Each segment of the
+// spline takes up an equal part of the range [0,1] of t.
+//
+// There must be at least 4 control points and t must be in the range [0,1], or
+// this function will panic.
+func CatmullRomSpline2D(t float32, cPoints []Vec2) Vec2 {
+	i, u, _ := catmullRomSegment(t, len(cPoints))
+	return CatmullRomCurve2D(u, cPoints[i], cPoints[i+1], cPoints[i+2], cPoints[i+3])
+}
+
+// CatmullRomSpline3D interpolates the point t on the centripetal Catmull-Rom
+// spline through cPoints[1] to cPoints[len(cPoints)-2]. The first and last
+// control points only shape the tangents at the ends. Each segment of the
+// spline takes up an equal part of the range [0,1] of t.
+//
+// There must be at least 4 control points and t must be in the range [0,1], or
+// this function will panic.
+func CatmullRomSpline3D(t float32, cPoints []Vec3) Vec3 {
+	i, u, _ := catmullRomSegment(t, len(cPoints))
+	return CatmullRomCurve3D(u, cPoints[i], cPoints[i+1], cPoints[i+2], cPoints[i+3])
+}
+
+// CatmullRomSplineDerivative2D returns the derivative with respect to t of the
+// spline of CatmullRomSpline2D.
+func CatmullRomSplineDerivative2D(t float32, cPoints []Vec2) Vec2 {
+	i, u, segments := catmullRomSegment(t, len(cPoints))
+	return CatmullRomCurveDerivative2D(u, cPoints[i], cPoints[i+1], cPoints[i+2], cPoints[i+3]).Mul(float32(segments))
+}
+
+// CatmullRomSplineDerivative3D returns the derivative with respect to t of the
+// spline of CatmullRomSpline3D.
+func CatmullRomSplineDerivative3D(t float32, cPoints []Vec3) Vec3 {
+	i, u, segments := catmullRomSegment(t, len(cPoints))
+	return CatmullRomCurveDerivative3D(u, cPoints[i], cPoints[i+1], cPoints[i+2], cPoints[i+3]).Mul(float32(segments))
+}
+
+// MakeCatmullRomSpline2D samples numPoints points evenly spaced in the
+// parameter along the spline of CatmullRomSpline2D, from cPoints[1] to
+// cPoints[len(cPoints)-2].
+func MakeCatmullRomSpline2D(numPoints int, cPoints []Vec2) []Vec2 {
+	line := make([]Vec2, numPoints)
+	for i := range line {
+		line[i] = CatmullRomSpline2D(splineSample(i, numPoints, 0, 1), cPoints)
+	}
+	return line
+}
+
+// MakeCatmullRomSpline3D samples numPoints points evenly spaced in the
+// parameter along the spline of CatmullRomSpline3D, from cPoints[1] to
+// cPoints[len(cPoints)-2].
+func MakeCatmullRomSpline3D(numPoints int, cPoints []Vec3) []Vec3 {
+	line := make([]Vec3, numPoints)
+	for i := range line {
+		line[i] = CatmullRomSpline3D(splineSample(i, numPoints, 0, 1), cPoints)
+	}
+	return line
+}
+
+// BSpline2D interpolates the point t on the B-spline of the given degree with
+// the control points cPoints, using de Boor's algorithm. The knot vector must be
+// non-decreasing and have len(cPoints)+degree+1 elements; see
+// UniformBSplineKnots for the usual choices. The spline is defined for t in
+// the range [knots[degree], knots[len(cPoints)]].
+//
+// This function panics if t is out of range, or the number of knots doesn't
+// match the number of control points.
+func BSpline2D(t float32, degree int, knots []float32, cPoints []Vec2) Vec2 {
+	k := bsplineSpan(t, degree, knots, len(cPoints))
+
+	d := make([]Vec2, degree+1)
+	copy(d, cPoints[k-degree:k+1])
+	for r := 1; r <= degree; r++ {
+		for j := degree; j >= r; j-- {
+			i := j + k - degree
+			alpha := bsplineAlpha(t, knots[i], knots[i+degree-r+1])
+			d[j] = d[j-1].Mul(1 - alpha).Add(d[j].Mul(alpha))
+		}
+	}
+
+	return d[degree]
+}
+
+// BSpline3D interpolates the point t on the B-spline of the given degree with
+// the control points cPoints, using de Boor's algorithm. The knot vector must be
+// non-decreasing and have len(cPoints)+degree+1 elements; see
+// UniformBSplineKnots for the usual choices. The spline is defined for t in
+// the range [knots[degree], knots[len(cPoints)]].
+//
+// This function panics if t is out of range, or the number of knots doesn't
+// match the number of control points.
+func BSpline3D(t float32, degree int, knots []float32, cPoints []Vec3) Vec3 {
+	k := bsplineSpan(t, degree, knots, len(cPoints))
+
+	d := make([]Vec3, degree+1)
+	copy(d, cPoints[k-degree:k+1])
+	for r := 1; r <= degree; r++ {
+		for j := degree; j >= r; j-- {
+			i := j + k - degree
+			alpha := bsplineAlpha(t, knots[i], knots[i+degree-r+1])
+			d[j] = d[j-1].Mul(1 - alpha).Add(d[j].Mul(alpha))
+		}
+	}
+
+	return d[degree]
+}
+
+// BSplineDerivative2D returns the derivative with respect to t of the spline of
+// BSpline2D. The derivative is itself a B-spline, of one degree lower.
+func BSplineDerivative2D(t float32, degree int, knots []float32, cPoints []Vec2) Vec2 {
+	bsplineSpan(t, degree, knots, len(cPoints))
+	if degree == 0 {
+		return Vec2{}
+	}
+
+	deriv := make([]Vec2, len(cPoints)-1)
+	for i := range deriv {
+		if dt := knots[i+degree+1] - knots[i+1]; dt != 0 {
+			deriv[i] = cPoints[i+1].Sub(cPoints[i]).Mul(float32(degree) / dt)
+		}
+	}
+	return BSpline2D(t, degree-1, knots[1:len(knots)-1], deriv)
+}
+
+// BSplineDerivative3D returns the derivative with respect to t of the spline of
+// BSpline3D. The derivative is itself a B-spline, of one degree lower.
+func BSplineDerivative3D(t float32, degree int, knots []float32, cPoints []Vec3) Vec3 {
+	bsplineSpan(t, degree, knots, len(cPoints))
+	if degree == 0 {
+		return Vec3{}
+	}
+
+	deriv := make([]Vec3, len(cPoints)-1)
+	for i := range deriv {
+		if dt := knots[i+degree+1] - knots[i+1]; dt != 0 {
+			deriv[i] = cPoints[i+1].Sub(cPoints[i]).Mul(float32(degree) / dt)
+		}
+	}
+	return BSpline3D(t, degree-1, knots[1:len(knots)-1], deriv)
+}
+
+// MakeBSpline2D samples numPoints points evenly spaced in the parameter along
+// the spline of BSpline2D, including both ends of its range.
+func MakeBSpline2D(numPoints int, degree int, knots []float32, cPoints []Vec2) []Vec2 {
+	line := make([]Vec2, numPoints)
+	for i := range line {
+		line[i] = BSpline2D(splineSample(i, numPoints, knots[degree], knots[len(cPoints)]), degree, knots, cPoints)
+	}
+	return line
+}
+
+// MakeBSpline3D samples numPoints points evenly spaced in the parameter along
+// the spline of BSpline3D, including both ends of its range.
+func MakeBSpline3D(numPoints int, degree int, knots []float32, cPoints []Vec3) []Vec3 {
+	line := make([]Vec3, numPoints)
+	for i := range line {
+		line[i] = BSpline3D(splineSample(i, numPoints, knots[degree], knots[len(cPoints)]), degree, knots, cPoints)
+	}
+	return line
+}
+
+// UniformBSplineKnots returns a uniform knot vector for a B-spline with
+// numPoints control points of the given degree, with the range [0,1]. A
+// clamped knot vector repeats the knots at the ends, so the spline starts at
+// the first control point and ends at the last one. Otherwise the spline only
+// approaches them, which makes it easier to join splines or close them into
+// loops.
+//
+// This panics if there aren't more control points than the degree.
+func UniformBSplineKnots(numPoints, degree int, clamped bool) []float32 {
+	if degree < 0 || numPoints <= degree {
+		panic("B-spline needs more control points than its degree")
+	}
+
+	knots := make([]float32, numPoints+degree+1)
+	for i := range knots {
+		knots[i] = float32(i-degree) / float32(numPoints-degree)
+		if clamped {
+			knots[i] = Clamp(knots[i], 0, 1)
+		}
+	}
+	return knots
+}
+
+// splineSample returns the parameter of the i'th of numPoints samples evenly
+// spaced in the range [lo,hi], which includes both ends exactly.
+func splineSample(i, numPoints int, lo, hi float32) float32 {
+	if i == 0 {
+		return lo
+	} else if i == numPoints-1 {
+		return hi
+	}
+	return lo + (hi-lo)*float32(i)/float32(numPoints-1)
+}
+
+// hermiteSegment finds the segment i of a Hermite spline containing t, and
+// returns the position u of t within it in the range [0,1] and its length dt.
+func hermiteSegment(t float32, knots []float32, numPoints, numTangents int) (i int, u, dt float32) {
+	if len(knots) < 2 || len(knots) != numPoints || len(knots) != numTangents {
+		panic("Hermite spline needs at least 2 knots, with a point and a tangent for each")
+	}
+	if t < knots[0] || t > knots[len(knots)-1] {
+		panic("t is out of the range of the hermite spline")
+	}
+
+	i = sort.Search(len(knots)-2, func(j int) bool { return knots[j+1] > t })
+	dt = knots[i+1] - knots[i]
+	return i, Clamp((t-knots[i])/dt, 0, 1), dt
+}
+
+// catmullRomInterval returns the knot interval of the centripetal
+// parameterization between two control points at the distance d, which is the
+// square root of d. Coincident points get an interval of 1 rather than 0 to
+// avoid dividing by zero.
+func catmullRomInterval(d float32) float32 {
+	if d == 0 {
+		return 1
+	}
+	return float32(math.Sqrt(float64(d)))
+}
+
+// catmullRomSegment finds the segment i of a Catmull-Rom spline with
+// numPoints control points containing t, and returns the position u of t
+// within it in the range [0,1] along with the number of segments.
+func catmullRomSegment(t float32, numPoints int) (i int, u float32, segments int) {
+	if numPoints < 4 {
+		panic("Catmull-Rom spline needs at least 4 control points")
+	}
+	if t < 0.0 || t > 1.0 {
+		panic("Can't interpolate on catmull-rom spline with t out of range [0.0,1.0]")
+	}
+
+	segments = numPoints - 3
+	s := t * float32(segments)
+	i = int(s)
+	if i >= segments {
+		i = segments - 1
+	}
+	return i, Clamp(s-float32(i), 0, 1), segments
+}
+
+// bsplineSpan checks the arguments of a B-spline and returns the index k of the
+// knot span [knots[k], knots[k+1]) containing t.
+func bsplineSpan(t float32, degree int, knots []float32, numPoints int) int {
+	if degree < 0 || numPoints <= degree {
+		panic("B-spline needs more control points than its degree")
+	}
+	if len(knots) != numPoints+degree+1 {
+		panic("B-spline needs len(cPoints)+degree+1 knots")
+	}
+	if t < knots[degree] || t > knots[numPoints] {
+		panic("t is out of the range of the b-spline")
+	}
+
+	return degree + sort.Search(numPoints-degree-1, func(j int) bool { return knots[degree+1+j] > t })
+}
+
+// bsplineAlpha returns how far t is between the knots lo and hi, or 0 if they
+// are equal.
+func bsplineAlpha(t, lo, hi float32) float32 {
+	if hi == lo {
+		return 0
+	}
+	return (t - lo) / (hi - lo)
+}
@@ -0,0 +1,212 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+// numericDerivative3D approximates the derivative of f at t with central
+// differences, or one-sided ones at the ends of [lo,hi].
+func numericDerivative3D(f func(float32) Vec3, t, lo, hi float32) Vec3 {
+	const h = 1e-2
+	a, b := t-h, t+h
+	if a < lo {
+		a = lo
+	}
+	if b > hi {
+		b = hi
+	}
+	return f(b).Sub(f(a)).Mul(1 / (b - a))
+}
+
+func TestHermite(t *testing.T) {
+	t.Parallel()
+
+	p1, m1, p2, m2 := Vec2{0, 0}, Vec2{1, 2}, Vec2{3, 1}, Vec2{-1, 1}
+	if v := HermiteCurve2D(0, p1, m1, p2, m2); !v.ApproxEqual(p1) {
+		t.Errorf("HermiteCurve2D(0) = %v, expected %v", v, p1)
+	}
+	if v := HermiteCurve2D(1, p1, m1, p2, m2); !v.ApproxEqual(p2) {
+		t.Errorf("HermiteCurve2D(1) = %v, expected %v", v, p2)
+	}
+	if v := HermiteCurveDerivative2D(0, p1, m1, p2, m2); !v.ApproxEqual(m1) {
+		t.Errorf("HermiteCurveDerivative2D(0) = %v, expected %v", v, m1)
+	}
+	if v := HermiteCurveDerivative2D(1, p1, m1, p2, m2); !v.ApproxEqual(m2) {
+		t.Errorf("HermiteCurveDerivative2D(1) = %v, expected %v", v, m2)
+	}
+
+	knots := []float32{0, 0.5, 2, 3}
+	points := []Vec3{{0, 0, 0}, {1, 1, 0}, {2, 0, 1}, {4, 1, 1}}
+	tangents := []Vec3{{1, 0, 0}, {1, 0, 1}, {0, -1, 1}, {2, 0, 0}}
+	for i, k := range knots {
+		if v := HermiteSpline3D(k, knots, points, tangents); !v.ApproxEqualThreshold(points[i], 1e-5) {
+			t.Errorf("HermiteSpline3D(%v) = %v, expected %v", k, v, points[i])
+		}
+		if v := HermiteSplineDerivative3D(k, knots, points, tangents); !v.ApproxEqualThreshold(tangents[i], 1e-5) {
+			t.Errorf("HermiteSplineDerivative3D(%v) = %v, expected %v", k, v, tangents[i])
+		}
+	}
+
+	f := func(x float32) Vec3 { return HermiteSpline3D(x, knots, points, tangents) }
+	for _, x := range []float32{0.25, 1, 1.7, 2.5} {
+		d := HermiteSplineDerivative3D(x, knots, points, tangents)
+		if n := numericDerivative3D(f, x, 0, 3); !d.ApproxEqualThreshold(n, 1e-2) {
+			t.Errorf("HermiteSplineDerivative3D(%v) = %v, numerically %v", x, d, n)
+		}
+	}
+
+	line := MakeHermiteSpline3D(7, knots, points, tangents)
+	if len(line) != 7 || line[0] != points[0] || !line[6].ApproxEqual(points[3]) {
+		t.Errorf("MakeHermiteSpline3D got %v", line)
+	}
+}
+
+// barryGoldman evaluates the centripetal Catmull-Rom curve between p1 and p2
+// with the pyramidal formulation of Barry and Goldman.
+func barryGoldman(u float32, p0, p1, p2, p3 Vec3) Vec3 {
+	t0 := float32(0)
+	t1 := t0 + float32(math.Sqrt(float64(p1.Sub(p0).Len())))
+	t2 := t1 + float32(math.Sqrt(float64(p2.Sub(p1).Len())))
+	t3 := t2 + float32(math.Sqrt(float64(p3.Sub(p2).Len())))
+	t := t1 + u*(t2-t1)
+
+	lerp := func(a, b Vec3, ta, tb float32) Vec3 {
+		return a.Mul((tb - t) / (tb - ta)).Add(b.Mul((t - ta) / (tb - ta)))
+	}
+	a1, a2, a3 := lerp(p0, p1, t0, t1), lerp(p1, p2, t1, t2), lerp(p2, p3, t2, t3)
+	b1, b2 := lerp(a1, a2, t0, t2), lerp(a2, a3, t1, t3)
+	return lerp(b1, b2, t1, t2)
+}
+
+func TestCatmullRom(t *testing.T) {
+	t.Parallel()
+
+	cPoints := []Vec3{{0, 0, 0}, {1, 2, 0}, {3, 2, 1}, {3.5, 0, 1}, {6, 0, 0}, {6, 1, 3}}
+	for i := 0; i+3 < len(cPoints); i++ {
+		for _, u := range []float32{0, 0.2, 0.5, 0.9, 1} {
+			v := CatmullRomCurve3D(u, cPoints[i], cPoints[i+1], cPoints[i+2], cPoints[i+3])
+			if expected := barryGoldman(u, cPoints[i], cPoints[i+1], cPoints[i+2], cPoints[i+3]); !v.ApproxEqualThreshold(expected, 1e-4) {
+				t.Errorf("CatmullRomCurve3D(%v) of segment %d = %v, expected %v", u, i, v, expected)
+			}
+		}
+	}
+
+	// The spline passes through the inner control points, with a continuous
+	// tangent direction
+	segments := len(cPoints) - 3
+	for i := 0; i <= segments; i++ {
+		x := float32(i) / float32(segments)
+		if v := CatmullRomSpline3D(x, cPoints); !v.ApproxEqualThreshold(cPoints[i+1], 1e-5) {
+			t.Errorf("CatmullRomSpline3D(%v) = %v, expected %v", x, v, cPoints[i+1])
+		}
+		if i == 0 || i == segments {
+			continue
+		}
+		before := CatmullRomSplineDerivative3D(x-1e-4, cPoints).Normalize()
+		after := CatmullRomSplineDerivative3D(x+1e-4, cPoints).Normalize()
+		if !before.ApproxEqualThreshold(after, 1e-2) {
+			t.Errorf("CatmullRomSplineDerivative3D isn't continuous at %v: %v and %v", x, before, after)
+		}
+	}
+
+	f := func(x float32) Vec3 { return CatmullRomSpline3D(x, cPoints) }
+	for _, x := range []float32{0, 0.1, 0.4, 0.8, 1} {
+		d := CatmullRomSplineDerivative3D(x, cPoints)
+		if n := numericDerivative3D(f, x, 0, 1); !d.ApproxEqualThreshold(n, 0.1) {
+			t.Errorf("CatmullRomSplineDerivative3D(%v) = %v, numerically %v", x, d, n)
+		}
+	}
+
+	// Evenly spaced collinear points give a straight line at constant speed
+	line := MakeCatmullRomSpline2D(5, []Vec2{{0, 0}, {1, 1}, {2, 2}, {3, 3}})
+	for i, v := range line {
+		x := 1 + float32(i)/4
+		if !v.ApproxEqualThreshold(Vec2{x, x}, 1e-5) {
+			t.Errorf("MakeCatmullRomSpline2D point %d = %v, expected %v", i, v, Vec2{x, x})
+		}
+	}
+}
+
+func TestBSpline(t *testing.T) {
+	t.Parallel()
+
+	cPoints := []Vec2{{0, 0}, {1, 3}, {4, 3}, {5, 0}}
+
+	// A clamped cubic B-spline with 4 control points is a Bezier curve
+	knots := UniformBSplineKnots(4, 3, true)
+	for _, x := range []float32{0, 0.3, 0.5, 1} {
+		v := BSpline2D(x, 3, knots, cPoints)
+		if expected := CubicBezierCurve2D(x, cPoints[0], cPoints[1], cPoints[2], cPoints[3]); !v.ApproxEqualThreshold(expected, 1e-5) {
+			t.Errorf("BSpline2D(%v) = %v, expected %v", x, v, expected)
+		}
+	}
+
+	// The uniform cubic B-spline basis
+	knots = UniformBSplineKnots(4, 3, false)
+	for _, x := range []float32{0, 0.3, 0.5, 1} {
+		v := BSpline2D(x, 3, knots, cPoints)
+		expected := cPoints[0].Mul((1 - x) * (1 - x) * (1 - x)).
+			Add(cPoints[1].Mul(3*x*x*x - 6*x*x + 4)).
+			Add(cPoints[2].Mul(-3*x*x*x + 3*x*x + 3*x + 1)).
+			Add(cPoints[3].Mul(x * x * x)).Mul(1.0 / 6)
+		if !v.ApproxEqualThreshold(expected, 1e-5) {
+			t.Errorf("uniform BSpline2D(%v) = %v, expected %v", x, v, expected)
+		}
+	}
+
+	// A linear B-spline is the polyline through its control points
+	knots = UniformBSplineKnots(4, 1, true)
+	for i, k := range knots[1:5] {
+		if v := BSpline2D(k, 1, knots, cPoints); !v.ApproxEqualThreshold(cPoints[i], 1e-5) {
+			t.Errorf("linear BSpline2D(%v) = %v, expected %v", k, v, cPoints[i])
+		}
+	}
+
+	// A non-uniform quadratic spline with a repeated interior knot
+	cPoints3 := []Vec3{{0, 0, 0}, {1, 2, 0}, {2, 2, 2}, {3, 0, 1}, {5, 1, 0}, {6, 3, 3}}
+	knots = []float32{0, 0, 0, 0.2, 0.5, 0.5, 1, 1, 1}
+	f := func(x float32) Vec3 { return BSpline3D(x, 2, knots, cPoints3) }
+	if v := f(0.5); !v.ApproxEqualThreshold(cPoints3[3], 1e-5) {
+		t.Errorf("BSpline3D at a double knot = %v, expected %v", v, cPoints3[3])
+	}
+	for _, x := range []float32{0.05, 0.1, 0.3, 0.45, 0.7, 0.95} {
+		d := BSplineDerivative3D(x, 2, knots, cPoints3)
+		if n := numericDerivative3D(f, x, 0, 1); !d.ApproxEqualThreshold(n, 5e-2) {
+			t.Errorf("BSplineDerivative3D(%v) = %v, numerically %v", x, d, n)
+		}
+	}
+
+	line := MakeBSpline3D(11, 2, knots, cPoints3)
+	if len(line) != 11 || !line[0].ApproxEqual(cPoints3[0]) || !line[10].ApproxEqual(cPoints3[5]) {
+		t.Errorf("MakeBSpline3D got %v", line)
+	}
+}
+
+func TestSplinePanics(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(){
+		"hermite t":        func() { HermiteCurve2D(1.5, Vec2{}, Vec2{}, Vec2{}, Vec2{}) },
+		"hermite knots":    func() { HermiteSpline2D(0, []float32{0, 1}, []Vec2{{}}, []Vec2{{}, {}}) },
+		"catmull-rom size": func() { CatmullRomSpline3D(0, []Vec3{{}, {}, {}}) },
+		"catmull-rom t":    func() { CatmullRomSpline3D(-0.1, []Vec3{{}, {}, {}, {}}) },
+		"b-spline knots":   func() { BSpline2D(0, 2, []float32{0, 1}, []Vec2{{}, {}, {}}) },
+		"b-spline t":       func() { BSpline2D(1.1, 1, UniformBSplineKnots(2, 1, true), []Vec2{{}, {}}) },
+		"b-spline degree":  func() { UniformBSplineKnots(3, 3, false) },
+	}
+	for name, f := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Shape is a convex shape in 3D space, described by its support mapping.
+//
+// Support returns a point of the shape that is farthest in the direction dir,
+// that is, one that maximizes its dot product with dir. The direction is not
+// necessarily normalized, and may be zero, in which case any point of the
+// shape will do. This is all that GJKIntersects, GJKDistance and
+// EPAPenetration need to know about a shape.
+//
+// Sphere, AABB3, OBB, Capsule, ConvexHull and TransformedShape are shapes.
+type Shape interface {
+	Support(dir Vec3) Vec3
+}
+
+// Capsule is the set of points within Radius of the segment from A to B,
+// that is, a cylinder capped by two half-spheres.
+type Capsule struct {
+	A, B   Vec3
+	Radius float32
+}
+
+// Support returns the point of the capsule that is farthest in the direction
+// dir, which makes Capsule a Shape.
+func (c Capsule) Support(dir Vec3) Vec3 {
+	p := c.A
+	if dir.Dot(c.B) > dir.Dot(c.A) {
+		p = c.B
+	}
+	if l := dir.Len(); l > 0 {
+		p = p.Add(dir.Mul(c.Radius / l))
+	}
+
+	return p
+}
+
+// ConvexHull is a convex polyhedron given by its vertices, and optionally the
+// triangles on its surface as indices in Vertices, three per triangle, in the
+// layout of ConvexHull3D. Only the vertices matter for collision detection, and
+// they may just as well include points inside of the hull, at the cost of
+// speed.
+type ConvexHull struct {
+	Vertices []Vec3
+	Indices  []int
+}
+
+// NewConvexHull returns the convex hull of points, see ConvexHull3D.
+func NewConvexHull(points []Vec3) ConvexHull {
+	vertices, indices := ConvexHull3D(points)
+	return ConvexHull{vertices, indices}
+}
+
+// Support returns the vertex of the hull that is farthest in the direction
+// dir, which makes ConvexHull a Shape. This takes linear time in the number of
+// vertices. The hull must have at least one vertex.
+func (h ConvexHull) Support(dir Vec3) Vec3 {
+	best, bestDot := h.Vertices[0], h.Vertices[0].Dot(dir)
+	for _, v := range h.Vertices[1:] {
+		if d := v.Dot(dir); d > bestDot {
+			best, bestDot = v, d
+		}
+	}
+
+	return best
+}
+
+// TransformedShape is a Shape transformed by the affine homogeneous matrix
+// Transform, which may include rotation, translation, and uniform or
+// non-uniform scale. The support point is found without inverting the matrix,
+// since the farthest point of the transformed shape in a direction is the
+// transformed farthest point of the original shape in the direction
+// multiplied by the transpose of the matrix.
+type TransformedShape struct {
+	Shape     Shape
+	Transform Mat4
+}
+
+// Support returns the point of the transformed shape that is farthest in the
+// direction dir, which makes TransformedShape a Shape.
+func (t TransformedShape) Support(dir Vec3) Vec3 {
+	local := t.Transform.Mat3().Transpose().Mul3x1(dir)
+	return TransformCoordinate(t.Shape.Support(local), t.Transform)
+}
@@ -0,0 +1,219 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// SVD is the (thin) singular value decomposition of an MxN MatMxN A. That is,
+// A = U*S*V^T where, with K = min(M, N), U is an MxK matrix and V an NxK matrix
+// with orthonormal columns, and S is the KxK diagonal matrix of singular
+// values.
+//
+// The singular values are non-negative and sorted in descending order. Columns
+// of U belonging to a singular value of zero are zero, rather than being
+// completed to an orthonormal basis.
+type SVD struct {
+	u, v *MatMxN
+	s    []float32
+}
+
+// SVD computes the singular value decomposition of mat with the one-sided
+// Jacobi (Hestenes) method, which orthogonalizes the columns of the matrix
+// with plane rotations and is accurate even for tiny singular values. The
+// decomposition is stored in dst, reusing its memory if possible. If dst is
+// nil, a new SVD will be allocated. The value returned is dst.
+//
+// If mat is nil a NilMatrixError is returned. If the iteration does not
+// converge within opts.MaxIterations sweeps, a NoConvergenceError is returned
+// along with the best approximation found.
+func (mat *MatMxN) SVD(dst *SVD, opts JacobiOptions) (*SVD, error) {
+	if mat == nil {
+		return nil, NilMatrixError{}
+	}
+
+	if dst == nil {
+		dst = &SVD{}
+	}
+
+	// Work on whichever of mat and its transpose is tall, A^T = V*S*U^T
+	transposed := mat.m < mat.n
+	if transposed {
+		dst.u = mat.Transpose(dst.u)
+	} else {
+		if dst.u == nil {
+			dst.u = NewMatrix(mat.m, mat.n)
+		}
+		CopyMatMN(dst.u, mat)
+	}
+
+	m, n := dst.u.m, dst.u.n
+	dst.v = IdentN(dst.v, n)
+	ok := hestenes(dst.u.dat, dst.v.dat, m, n, opts)
+
+	if cap(dst.s) < n {
+		dst.s = make([]float32, n)
+	}
+	dst.s = dst.s[:n]
+
+	// The columns are now orthogonal; their lengths are the singular values
+	for j := 0; j < n; j++ {
+		col := dst.u.dat[j*m : (j+1)*m]
+		var nrm float64
+		for _, x := range col {
+			nrm = math.Hypot(nrm, float64(x))
+		}
+		dst.s[j] = float32(nrm)
+		if nrm != 0 {
+			for i := range col {
+				col[i] /= float32(nrm)
+			}
+		}
+	}
+
+	dst.sort()
+	if transposed {
+		dst.u, dst.v = dst.v, dst.u
+	}
+
+	if !ok {
+		return dst, NoConvergenceError{}
+	}
+	return dst, nil
+}
+
+// hestenes orthogonalizes the columns of the column-major mxn matrix a in
+// place, accumulating the rotations in the nxn matrix v. It returns whether
+// the iteration converged.
+func hestenes(a, v []float32, m, n int, opts JacobiOptions) bool {
+	tol, maxIter := opts.tolerance(), opts.maxIterations()
+
+	for sweep := 0; sweep < maxIter; sweep++ {
+		rotated := false
+
+		for p := 0; p < n-1; p++ {
+			colP := a[p*m : (p+1)*m]
+			for q := p + 1; q < n; q++ {
+				colQ := a[q*m : (q+1)*m]
+
+				var alpha, beta, gamma float32
+				for i := range colP {
+					alpha += colP[i] * colP[i]
+					beta += colQ[i] * colQ[i]
+					gamma += colP[i] * colQ[i]
+				}
+				if gamma == 0 || Abs(gamma) <= tol*float32(math.Sqrt(float64(alpha)*float64(beta))) {
+					continue
+				}
+				rotated = true
+
+				// The rotation making the two columns orthogonal
+				zeta := (beta - alpha) / (2 * gamma)
+				t := 1 / (Abs(zeta) + float32(math.Sqrt(float64(zeta*zeta+1))))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / float32(math.Sqrt(float64(t*t+1)))
+				s := t * c
+
+				for i := range colP {
+					x, y := colP[i], colQ[i]
+					colP[i] = c*x - s*y
+					colQ[i] = s*x + c*y
+				}
+				vp, vq := v[p*n:(p+1)*n], v[q*n:(q+1)*n]
+				for i := range vp {
+					x, y := vp[i], vq[i]
+					vp[i] = c*x - s*y
+					vq[i] = s*x + c*y
+				}
+			}
+		}
+
+		if !rotated {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sort sorts the singular values in descending order, along with the columns
+// of u and v (before any transposition).
+func (svd *SVD) sort() {
+	m, n := svd.u.m, svd.u.n
+	for i := 0; i < n-1; i++ {
+		max := i
+		for j := i + 1; j < n; j++ {
+			if svd.s[j] > svd.s[max] {
+				max = j
+			}
+		}
+		if max == i {
+			continue
+		}
+
+		svd.s[i], svd.s[max] = svd.s[max], svd.s[i]
+		for r := 0; r < m; r++ {
+			svd.u.dat[i*m+r], svd.u.dat[max*m+r] = svd.u.dat[max*m+r], svd.u.dat[i*m+r]
+		}
+		for r := 0; r < n; r++ {
+			svd.v.dat[i*n+r], svd.v.dat[max*n+r] = svd.v.dat[max*n+r], svd.v.dat[i*n+r]
+		}
+	}
+}
+
+// Values stores the singular values in dst, which is Resized as necessary, and
+// returns it. They are sorted in descending order.
+func (svd *SVD) Values(dst *VecN) *VecN {
+	dst = dst.Resize(len(svd.s))
+	copy(dst.vec, svd.s)
+
+	return dst
+}
+
+// U stores the left singular vectors as the columns of dst, which is Reshaped
+// as necessary, and returns it.
+func (svd *SVD) U(dst *MatMxN) *MatMxN {
+	dst = dst.Reshape(svd.u.m, svd.u.n)
+	CopyMatMN(dst, svd.u)
+
+	return dst
+}
+
+// V stores the right singular vectors as the columns of dst, which is
+// Reshaped as necessary, and returns it. Note that the decomposition is
+// U*S*V^T, so these are the rows of the last factor.
+func (svd *SVD) V(dst *MatMxN) *MatMxN {
+	dst = dst.Reshape(svd.v.m, svd.v.n)
+	CopyMatMN(dst, svd.v)
+
+	return dst
+}
+
+// Rank returns the numerical rank of the decomposed matrix, the number of
+// singular values that are larger than the largest one times max(M, N) times
+// the machine precision.
+func (svd *SVD) Rank() int {
+	if len(svd.s) == 0 {
+		return 0
+	}
+
+	size := svd.u.m
+	if svd.v.m > size {
+		size = svd.v.m
+	}
+	tol := float32(size) * machineEpsilon * svd.s[0]
+
+	rank := 0
+	for _, s := range svd.s {
+		if s > tol {
+			rank++
+		}
+	}
+
+	return rank
+}
@@ -0,0 +1,97 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSVD(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(3))
+	near := func(a, b float32) bool { return Abs(a-b) < 1e-4 }
+
+	for _, dims := range [][2]int{{4, 4}, {7, 3}, {3, 7}} {
+		m := NewMatrix(dims[0], dims[1])
+		for i := range m.dat {
+			m.dat[i] = r.Float32()*2 - 1
+		}
+
+		svd, err := m.SVD(nil, JacobiOptions{})
+		if err != nil {
+			t.Fatalf("SVD of %dx%d matrix returned error: %v", dims[0], dims[1], err)
+		}
+
+		u, s, v := svd.U(nil), svd.Values(nil), svd.V(nil)
+		k := s.Size()
+		if rows, cols := u.NumRowCols(); rows != dims[0] || cols != k {
+			t.Errorf("U of %dx%d matrix is %dx%d", dims[0], dims[1], rows, cols)
+		}
+		if rows, cols := v.NumRowCols(); rows != dims[1] || cols != k {
+			t.Errorf("V of %dx%d matrix is %dx%d", dims[0], dims[1], rows, cols)
+		}
+
+		reconstructed := u.MulMxN(nil, DiagN(nil, s)).MulMxN(nil, v.Transpose(nil))
+		if !reconstructed.ApproxEqualFunc(m, near) {
+			t.Errorf("U * S * V^T is %v, expected %v", reconstructed, m)
+		}
+
+		ident := IdentN(nil, k)
+		if prod := u.Transpose(nil).MulMxN(nil, u); !prod.ApproxEqualFunc(ident, near) {
+			t.Errorf("U is not orthonormal: U^T * U = %v", prod)
+		}
+		if prod := v.Transpose(nil).MulMxN(nil, v); !prod.ApproxEqualFunc(ident, near) {
+			t.Errorf("V is not orthonormal: V^T * V = %v", prod)
+		}
+
+		for i := 1; i < k; i++ {
+			if s.Get(i) > s.Get(i-1) || s.Get(i) < 0 {
+				t.Errorf("Singular values are not sorted and non-negative: %v", s.Raw())
+			}
+		}
+		if rank := svd.Rank(); rank != k {
+			t.Errorf("Rank of random %dx%d matrix is %d, expected %d", dims[0], dims[1], rank, k)
+		}
+	}
+}
+
+func TestSVDRankDeficient(t *testing.T) {
+	t.Parallel()
+
+	// The third column is the sum of the first two
+	m := NewMatrixFromData([]float32{
+		1, 2, 0, 1,
+		0, 1, 3, 1,
+		1, 3, 3, 2,
+	}, 4, 3)
+
+	svd, err := m.SVD(nil, JacobiOptions{})
+	if err != nil {
+		t.Fatalf("SVD returned error: %v", err)
+	}
+	if rank := svd.Rank(); rank != 2 {
+		t.Errorf("Rank is %d, expected 2 (singular values %v)", rank, svd.Values(nil).Raw())
+	}
+
+	if _, err := (*MatMxN)(nil).SVD(nil, JacobiOptions{}); err != (NilMatrixError{}) {
+		t.Errorf("SVD of nil matrix returned %v, expected NilMatrixError", err)
+	}
+}
+
+func BenchmarkSVD(b *testing.B) {
+	r := rand.New(rand.NewSource(4))
+	m := NewMatrix(8, 8)
+	for i := range m.dat {
+		m.dat[i] = r.Float32()
+	}
+	svd := &SVD{}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.SVD(svd, JacobiOptions{})
+	}
+}
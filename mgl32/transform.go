@@ -189,6 +189,74 @@ func ExtractMaxScale(m Mat4) float32 {
 	return float32(math.Sqrt(math.Max(scaleX, math.Max(scaleY, scaleZ))))
 }
 
+// Decompose splits an affine homogeneous matrix into a translation, rotation
+// and scale, such that ComposeTRS(translation, rotation, scale) reproduces m.
+// That is, m is taken to scale first, then rotate, then translate.
+//
+// If m contains a reflection (its upper 3x3 part has a negative determinant),
+// it is represented by negating the X scale. Since a reflection can be
+// expressed as negative scale on any axis combined with a suitable rotation,
+// this is not necessarily the same scale m was built from, but it composes to
+// the same matrix.
+//
+// Decomposition is only exact if the axes of m are orthogonal. If m has shear,
+// is not affine (its bottom row is not [0 0 0 1]), or has a scale of zero on
+// any axis, ok is false. In the case of shear, the result is still the closest
+// TRS approximation: the rotation is found by orthonormalizing the axes of m
+// in order X, Y, Z, and the scale is the length of each axis.
+func Decompose(m Mat4) (translation Vec3, rotation Quat, scale Vec3, ok bool) {
+	translation = Vec3{m[12], m[13], m[14]}
+	ok = m[3] == 0 && m[7] == 0 && m[11] == 0 && m[15] == 1
+
+	x, y, z := m.Col(0).Vec3(), m.Col(1).Vec3(), m.Col(2).Vec3()
+	scale = Vec3{x.Len(), y.Len(), z.Len()}
+	if scale[0] == 0 || scale[1] == 0 || scale[2] == 0 {
+		return translation, QuatIdent(), scale, false
+	}
+
+	if x.Dot(y.Cross(z)) < 0 {
+		scale[0] = -scale[0]
+	}
+
+	// Gram-Schmidt; with no shear this only normalizes the axes
+	x = x.Mul(1 / scale[0])
+	y = y.Mul(1 / scale[1])
+	z = z.Mul(1 / scale[2])
+
+	const shearTolerance = 1e-4
+	if Abs(x.Dot(y)) > shearTolerance || Abs(x.Dot(z)) > shearTolerance || Abs(y.Dot(z)) > shearTolerance {
+		ok = false
+	}
+
+	y = y.Sub(x.Mul(x.Dot(y))).Normalize()
+	z = x.Cross(y)
+
+	rot := Mat3FromCols(x, y, z).Mat4()
+	rotation = Mat4ToQuat(rot).Normalize()
+
+	return translation, rotation, scale, ok
+}
+
+// ComposeTRS builds the homogeneous matrix that scales by scale, then rotates
+// by rotation, then translates by translation. It is equivalent to
+//
+//	Translate3D(translation[0], translation[1], translation[2]).
+//		Mul4(rotation.Mat4()).
+//		Mul4(Scale3D(scale[0], scale[1], scale[2]))
+//
+// but cheaper, and is the inverse of Decompose.
+func ComposeTRS(translation Vec3, rotation Quat, scale Vec3) Mat4 {
+	m := rotation.Mat4()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m[i*4+j] *= scale[i]
+		}
+	}
+	m[12], m[13], m[14] = translation[0], translation[1], translation[2]
+
+	return m
+}
+
 // Mat4Normal calculates the Normal of the Matrix (aka the inverse transpose)
 func Mat4Normal(m Mat4) Mat3 {
 	n := m.Inv().Transpose()
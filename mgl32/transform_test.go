@@ -141,6 +141,80 @@ func TestExtractMaxScale(t *testing.T) {
 	}
 }
 
+func TestDecompose(t *testing.T) {
+	t.Parallel()
+
+	rot := QuatRotate(1.2, Vec3{1, -2, 0.5}.Normalize())
+	tests := []struct {
+		Translation Vec3
+		Rotation    Quat
+		Scale       Vec3
+	}{
+		{Vec3{}, QuatIdent(), Vec3{1, 1, 1}},
+		{Vec3{1, 2, 3}, rot, Vec3{2, 3, 4}},
+		{Vec3{-5, 0, 7}, QuatRotate(math.Pi, Vec3{0, 1, 0}), Vec3{0.5, 0.5, 0.5}},
+		{Vec3{1, 2, 3}, rot, Vec3{-2, 3, 4}},
+	}
+
+	for _, c := range tests {
+		m := Translate3D(c.Translation[0], c.Translation[1], c.Translation[2]).
+			Mul4(c.Rotation.Mat4()).
+			Mul4(Scale3D(c.Scale[0], c.Scale[1], c.Scale[2]))
+
+		if composed := ComposeTRS(c.Translation, c.Rotation, c.Scale); !composed.ApproxEqualThreshold(m, 1e-6) {
+			t.Errorf("ComposeTRS(%v, %v, %v) = %v, expected %v", c.Translation, c.Rotation, c.Scale, composed, m)
+		}
+
+		tr, r, s, ok := Decompose(m)
+		if !ok {
+			t.Errorf("Decompose(%v) failed", m)
+			continue
+		}
+		if !tr.ApproxEqual(c.Translation) || !s.ApproxEqualThreshold(c.Scale, 1e-5) || !r.OrientationEqualThreshold(c.Rotation, 1e-5) {
+			t.Errorf("Decompose(%v) = %v, %v, %v; expected %v, %v, %v", m, tr, r, s, c.Translation, c.Rotation, c.Scale)
+		}
+		if rt := ComposeTRS(tr, r, s); !rt.ApproxEqualThreshold(m, 1e-5) {
+			t.Errorf("Round trip of %v gives %v", m, rt)
+		}
+	}
+}
+
+func TestDecomposeReflection(t *testing.T) {
+	t.Parallel()
+
+	// Mirrored on Y, which Decompose represents as a mirror on X
+	m := Translate3D(1, 2, 3).Mul4(HomogRotate3DZ(0.3)).Mul4(Scale3D(2, -3, 4))
+
+	tr, r, s, ok := Decompose(m)
+	if !ok {
+		t.Fatalf("Decompose(%v) failed", m)
+	}
+	if s[0] >= 0 || s[1] <= 0 || s[2] <= 0 {
+		t.Errorf("Decompose of reflection gives scale %v, expected negative X only", s)
+	}
+	if rt := ComposeTRS(tr, r, s); !rt.ApproxEqualThreshold(m, 1e-5) {
+		t.Errorf("Round trip of %v gives %v", m, rt)
+	}
+}
+
+func TestDecomposeFailure(t *testing.T) {
+	t.Parallel()
+
+	sheared := Ident4()
+	sheared.Set(0, 1, 0.5)
+	if _, _, _, ok := Decompose(sheared); ok {
+		t.Errorf("Decompose of sheared matrix succeeded")
+	}
+
+	if _, _, _, ok := Decompose(Perspective(1, 1, 0.1, 100)); ok {
+		t.Errorf("Decompose of projection matrix succeeded")
+	}
+
+	if _, r, _, ok := Decompose(Scale3D(1, 0, 1)); ok || r != QuatIdent() {
+		t.Errorf("Decompose of degenerate matrix = %v, %v; expected identity, false", r, ok)
+	}
+}
+
 func TestTransformCoordinate(t *testing.T) {
 	tests := [...]struct {
 		v Vec3
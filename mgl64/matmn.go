@@ -511,3 +511,45 @@ type NilMatrixError struct{}
 func (me NilMatrixError) Error() string {
 	return "the matrix is nil"
 }
+
+// SingularMatrixError is returned when an operation requires an invertible
+// matrix, but the matrix given is singular (its determinant is zero).
+type SingularMatrixError struct{}
+
+func (me SingularMatrixError) Error() string {
+	return "the matrix is singular"
+}
+
+// DimensionMismatchError is returned when the sizes of the operands to a
+// function are incompatible, for instance when a vector's size does not
+// match the number of rows of a matrix.
+type DimensionMismatchError struct{}
+
+func (me DimensionMismatchError) Error() string {
+	return "the dimensions of the operands do not match"
+}
+
+// UnderdeterminedError is returned when an operation requires a matrix with
+// at least as many rows as columns, such as a least squares fit, but the matrix
+// given has fewer rows than columns.
+type UnderdeterminedError struct{}
+
+func (me UnderdeterminedError) Error() string {
+	return "the matrix has fewer rows than columns"
+}
+
+// NotPositiveDefiniteError is returned when an operation requires a symmetric
+// positive definite matrix, but the matrix given is not positive definite.
+type NotPositiveDefiniteError struct{}
+
+func (me NotPositiveDefiniteError) Error() string {
+	return "the matrix is not positive definite"
+}
+
+// NoConvergenceError is returned when an iterative algorithm did not reach the
+// requested tolerance within the maximum number of iterations.
+type NoConvergenceError struct{}
+
+func (me NoConvergenceError) Error() string {
+	return "the iteration did not converge"
+}
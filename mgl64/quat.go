@@ -11,11 +11,11 @@ import (
 )
 
 // RotationOrder is the order in which rotations will be transformed for the
-// purposes of AnglesToQuat.
+// purposes of AnglesToQuat and QuatToAngles.
 type RotationOrder int
 
 // The RotationOrder constants represent a series of rotations along the given
-// axes for the use of AnglesToQuat.
+// axes for the use of AnglesToQuat and QuatToAngles.
 const (
 	XYX RotationOrder = iota
 	XYZ
@@ -261,6 +261,113 @@ func QuatNlerp(q1, q2 Quat, amount float64) Quat {
 	return QuatLerp(q1, q2, amount).Normalize()
 }
 
+// Log returns the natural logarithm of the quaternion. For a unit quaternion
+// representing a rotation of angle about axis, this is the pure quaternion
+// Quat{0, axis.Mul(angle/2)}.
+//
+// Note that q and q.Scale(-1) represent the same rotation, but have different
+// logarithms: one for the rotation the short way around, and one for the
+// rotation the long way around.
+func (q1 Quat) Log() Quat {
+	length := float64(q1.Len())
+	vLen := float64(q1.V.Len())
+	if vLen == 0 {
+		return Quat{float64(math.Log(length)), Vec3{}}
+	}
+
+	angle := math.Atan2(vLen, float64(q1.W))
+	return Quat{float64(math.Log(length)), q1.V.Mul(float64(angle / vLen))}
+}
+
+// Exp returns the exponential of the quaternion, the inverse of Log. For the
+// pure quaternion Quat{0, v} this is the unit quaternion rotating about v by
+// twice its length.
+func (q1 Quat) Exp() Quat {
+	e := math.Exp(float64(q1.W))
+	vLen := float64(q1.V.Len())
+	if vLen == 0 {
+		return Quat{float64(e), Vec3{}}
+	}
+
+	sn, cs := math.Sincos(vLen)
+	return Quat{float64(e * cs), q1.V.Mul(float64(e * sn / vLen))}
+}
+
+// Pow raises the quaternion to the power t, which is Exp(Log(q1) * t). For a
+// unit quaternion, this scales the angle of the rotation by t, so for instance
+// q1.Pow(0.5) is the rotation halfway between the identity and q1.
+func (q1 Quat) Pow(t float64) Quat {
+	return q1.Log().Scale(t).Exp()
+}
+
+// QuatSquad is *S*pherical and *Quad*rangle interpolation between q1 and q2,
+// using the inner control points s1 and s2. It is to QuatSlerp what a cubic
+// Bezier curve is to a line: it evaluates Slerp(Slerp(q1, q2, amount),
+// Slerp(s1, s2, amount), 2*amount*(1-amount)).
+//
+// With control points from QuatSquadControlPoint the curve through a series of
+// keys has a continuous angular velocity, unlike chaining QuatSlerp. See
+// QuatSquadSpline, which does this for a whole slice of keys.
+func QuatSquad(q1, q2, s1, s2 Quat, amount float64) Quat {
+	return QuatSlerp(QuatSlerp(q1, q2, amount), QuatSlerp(s1, s2, amount), 2*amount*(1-amount))
+}
+
+// QuatSquadControlPoint computes the inner control point of QuatSquad for the
+// key q, given the previous and next keys of the curve. It is
+//
+//	q * Exp(-(Log(q^-1 * next) + Log(q^-1 * prev)) / 4)
+//
+// The neighbours are negated if necessary to take the shorter rotation to
+// them. The keys should be normalized. For the first and last key of a curve,
+// where a neighbour is missing, the control point is the key itself.
+func QuatSquadControlPoint(prev, q, next Quat) Quat {
+	if q.Dot(prev) < 0 {
+		prev = prev.Scale(-1)
+	}
+	if q.Dot(next) < 0 {
+		next = next.Scale(-1)
+	}
+
+	inv := q.Inverse()
+	l := inv.Mul(next).Log().Add(inv.Mul(prev).Log())
+
+	return q.Mul(l.Scale(-0.25).Exp())
+}
+
+// QuatSquadSpline evaluates the SQUAD curve through all of the given keys at
+// the parameter t, which ranges from 0 at keys[0] to len(keys)-1 at the last
+// key; values outside that range are clamped. The curve passes through every
+// key at integer values of t and its angular velocity is continuous there.
+//
+// The control points are computed on the fly from the up to four keys around
+// t with QuatSquadControlPoint. If there are no keys, this returns the
+// identity.
+func QuatSquadSpline(keys []Quat, t float64) Quat {
+	switch len(keys) {
+	case 0:
+		return QuatIdent()
+	case 1:
+		return keys[0]
+	}
+
+	t = Clamp(t, 0, float64(len(keys)-1))
+	i := int(t)
+	if i == len(keys)-1 {
+		i--
+	}
+
+	q1, q2 := keys[i], keys[i+1]
+	s1, s2 := q1, q2
+	if i > 0 {
+		s1 = QuatSquadControlPoint(keys[i-1], q1, q2)
+	}
+	if i+2 < len(keys) {
+		s2 = QuatSquadControlPoint(q1, q2, keys[i+2])
+	}
+
+	return QuatSquad(q1, q2, s1, s2, t-float64(i))
+}
+
 // AnglesToQuat performs a rotation in the specified order. If the order is not
 // a valid RotationOrder, this function will panic
 //
@@ -358,6 +465,106 @@ func AnglesToQuat(angle1, angle2, angle3 float64, order RotationOrder) Quat {
 	return ret
 }
 
+// QuatToAngles is the inverse of AnglesToQuat: it returns the three angles
+// that, rotated about the axes in the given order, produce the same rotation as
+// q. If the order is not a valid RotationOrder, this function will panic. The
+// quaternion does not need to be normalized.
+//
+// See Mat3ToAngles for the range of the angles returned and the behaviour at
+// gimbal lock.
+func QuatToAngles(q Quat, order RotationOrder) (angle1, angle2, angle3 float64) {
+	return Mat3ToAngles(q.Normalize().Mat4().Mat3(), order)
+}
+
+// Mat4ToAngles returns the rotation angles of the upper 3x3 part of m, which
+// must be a pure rotation, in the given order. See Mat3ToAngles.
+func Mat4ToAngles(m Mat4, order RotationOrder) (angle1, angle2, angle3 float64) {
+	return Mat3ToAngles(m.Mat3(), order)
+}
+
+// Mat3ToAngles returns the three angles that, rotated about the axes in the
+// given order, produce the pure rotation matrix m. That is, it is the inverse
+// of AnglesToQuat(angle1, angle2, angle3, order).Mat4(). If the order is not a
+// valid RotationOrder, this function will panic.
+//
+// Every rotation has two sets of angles; this returns the one where angle2 is
+// in [-Pi/2, Pi/2] for orders with three different axes (such as XYZ), or in
+// [0, Pi] for orders that repeat the first axis (such as ZXZ). Angle1 and
+// angle3 are in [-Pi, Pi].
+//
+// At gimbal lock, when angle2 is at the edge of its range, the first and third
+// axes line up and only the sum (or difference) of angle1 and angle3 is
+// defined. In that case angle3 is 0, and angle1 holds the whole rotation.
+func Mat3ToAngles(m Mat3, order RotationOrder) (angle1, angle2, angle3 float64) {
+	// Indices of the first two axes; the remaining axis is k. Parity is 1 if
+	// (i, j, k) is an even permutation of (X, Y, Z), and -1 otherwise.
+	var i, j int
+	var repeated bool
+	switch order {
+	case XYX:
+		i, j, repeated = 0, 1, true
+	case XYZ:
+		i, j = 0, 1
+	case XZX:
+		i, j, repeated = 0, 2, true
+	case XZY:
+		i, j = 0, 2
+	case YXY:
+		i, j, repeated = 1, 0, true
+	case YXZ:
+		i, j = 1, 0
+	case YZY:
+		i, j, repeated = 1, 2, true
+	case YZX:
+		i, j = 1, 2
+	case ZYZ:
+		i, j, repeated = 2, 1, true
+	case ZYX:
+		i, j = 2, 1
+	case ZXZ:
+		i, j, repeated = 2, 0, true
+	case ZXY:
+		i, j = 2, 0
+	default:
+		panic("Unsupported rotation order")
+	}
+	k := 3 - i - j
+	var parity float64 = 1
+	if (j-i+3)%3 != 1 {
+		parity = -1
+	}
+
+	at := func(row, col int) float64 {
+		return float64(m.At(row, col))
+	}
+
+	// Whether the first and third axes are (close to) aligned
+	const lockThreshold = 1e-6
+	var a1, a2, a3 float64
+
+	if repeated {
+		sy := math.Hypot(at(i, j), at(i, k))
+		a2 = math.Atan2(sy, at(i, i))
+		if sy > lockThreshold {
+			a1 = math.Atan2(at(j, i), -parity*at(k, i))
+			a3 = math.Atan2(at(i, j), parity*at(i, k))
+		} else {
+			a1 = math.Atan2(parity*at(k, j), at(j, j))
+		}
+	} else {
+		cy := math.Hypot(at(i, i), at(i, j))
+		a2 = math.Atan2(parity*at(i, k), cy)
+		if cy > lockThreshold {
+			a1 = math.Atan2(-parity*at(j, k), at(k, k))
+			a3 = math.Atan2(-parity*at(i, j), at(i, i))
+		} else {
+			a1 = math.Atan2(parity*at(k, j), at(j, j))
+		}
+	}
+
+	return float64(a1), float64(a2), float64(a3)
+}
+
 // Mat4ToQuat converts a pure rotation matrix into a quaternion
 func Mat4ToQuat(m Mat4) Quat {
 	// http://www.euclideanspace.com/maths/geometry/rotations/conversions/matrixToQuaternion/index.htm
@@ -115,6 +115,67 @@ func TestAnglesToQuatZYX(t *testing.T) {
 	}
 }
 
+func TestQuatToAngles(t *testing.T) {
+	t.Parallel()
+
+	orders := []RotationOrder{XYX, XYZ, XZX, XZY, YXY, YXZ, YZY, YZX, ZYZ, ZYX, ZXZ, ZXY}
+	tests := [][3]float64{
+		{0.3, 0.4, 0.5},
+		{-2.5, 1.2, 3},
+		{1, -0.7, -1.5},
+		{0, 0.1, 0},
+	}
+
+	for _, order := range orders {
+		repeated := order == XYX || order == XZX || order == YXY || order == YZY || order == ZYZ || order == ZXZ
+
+		for _, c := range tests {
+			a2 := c[1]
+			if repeated {
+				// Keep the middle angle in the range that's returned
+				a2 = Abs(a2)
+			}
+
+			q := AnglesToQuat(c[0], a2, c[2], order)
+			r1, r2, r3 := QuatToAngles(q, order)
+			if !FloatEqualThreshold(r1, c[0], 1e-4) || !FloatEqualThreshold(r2, a2, 1e-4) || !FloatEqualThreshold(r3, c[2], 1e-4) {
+				t.Errorf("QuatToAngles(AnglesToQuat(%v, %v, %v, %v)) = %v, %v, %v", c[0], a2, c[2], order, r1, r2, r3)
+			}
+
+			eq := FloatEqualFunc(1e-5)
+			if m1, m2, m3 := Mat4ToAngles(q.Mat4(), order); !eq(m1, r1) || !eq(m2, r2) || !eq(m3, r3) {
+				t.Errorf("Mat4ToAngles = %v, %v, %v; expected %v, %v, %v", m1, m2, m3, r1, r2, r3)
+			}
+		}
+	}
+}
+
+func TestQuatToAnglesGimbalLock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Order  RotationOrder
+		Angles [3]float64
+	}{
+		{XYZ, [3]float64{0.3, math.Pi / 2, 0.2}},
+		{ZYX, [3]float64{-1, -math.Pi / 2, 0.5}},
+		{YXZ, [3]float64{2, math.Pi / 2, -0.4}},
+		{ZXZ, [3]float64{0.6, 0, 0.3}},
+		{XYX, [3]float64{0.6, math.Pi, 0.3}},
+	}
+
+	for _, c := range tests {
+		q := AnglesToQuat(c.Angles[0], c.Angles[1], c.Angles[2], c.Order)
+		a1, a2, a3 := QuatToAngles(q, c.Order)
+		if a3 != 0 {
+			t.Errorf("QuatToAngles at gimbal lock for %v gives angle3 = %v, expected 0", c.Angles, a3)
+		}
+		if r := AnglesToQuat(a1, a2, a3, c.Order); !r.OrientationEqualThreshold(q, 1e-4) {
+			t.Errorf("QuatToAngles at gimbal lock for %v gives %v, %v, %v, which is a different rotation", c.Angles, a1, a2, a3)
+		}
+	}
+}
+
 func TestQuatMatRotateY(t *testing.T) {
 	t.Parallel()
 
@@ -730,3 +791,88 @@ func TestQuatDot(t *testing.T) {
 		}
 	}
 }
+
+func TestQuatLogExp(t *testing.T) {
+	t.Parallel()
+
+	tests := []Quat{
+		QuatIdent(),
+		QuatRotate(0.5, Vec3{1, 0, 0}),
+		QuatRotate(2, Vec3{1, 2, 3}.Normalize()),
+		QuatRotate(3, Vec3{0, -1, 0}).Scale(-1),
+		{2, Vec3{1, -1, 0.5}},
+	}
+
+	for _, q := range tests {
+		if r := q.Log().Exp(); !r.ApproxEqualThreshold(q, 1e-5) {
+			t.Errorf("Exp(Log(%v)) = %v", q, r)
+		}
+	}
+
+	q := QuatRotate(1.5, Vec3{0, 0, 1})
+	if l := q.Log(); !l.ApproxEqualThreshold(Quat{0, Vec3{0, 0, 0.75}}, 1e-6) {
+		t.Errorf("Log(%v) = %v, expected %v", q, l, Quat{0, Vec3{0, 0, 0.75}})
+	}
+}
+
+func TestQuatPow(t *testing.T) {
+	t.Parallel()
+
+	axis := Vec3{1, -2, 2}.Normalize()
+	q := QuatRotate(1.2, axis)
+
+	tests := []float64{0, 0.25, 0.5, 1, 2, -1}
+	for _, p := range tests {
+		if r := q.Pow(p); !r.OrientationEqualThreshold(QuatRotate(1.2*p, axis), 1e-5) {
+			t.Errorf("%v.Pow(%v) = %v, expected %v", q, p, r, QuatRotate(1.2*p, axis))
+		}
+	}
+
+	if r := q.Pow(0.5).Mul(q.Pow(0.5)); !r.ApproxEqualThreshold(q, 1e-5) {
+		t.Errorf("Square of %v.Pow(0.5) is %v", q, r)
+	}
+}
+
+func TestQuatSquadSpline(t *testing.T) {
+	t.Parallel()
+
+	keys := []Quat{
+		QuatIdent(),
+		QuatRotate(1, Vec3{0, 1, 0}),
+		QuatRotate(1, Vec3{1, 0, 0}).Mul(QuatRotate(1, Vec3{0, 1, 0})),
+		QuatRotate(2, Vec3{0, 0, 1}),
+		// Same rotation as a key near the previous one, but in the other hemisphere
+		QuatRotate(2.5, Vec3{0, 0, 1}).Scale(-1),
+	}
+
+	// Passes through every key
+	for i, k := range keys {
+		if r := QuatSquadSpline(keys, float64(i)); !r.OrientationEqualThreshold(k, 1e-5) {
+			t.Errorf("QuatSquadSpline at key %d is %v, expected %v", i, r, k)
+		}
+	}
+
+	// Angular velocity is continuous at the inner keys
+	const h = 1e-2
+	for i := 1; i < len(keys)-1; i++ {
+		ti := float64(i)
+		before := QuatSquadSpline(keys, ti-h).Inverse().Mul(QuatSquadSpline(keys, ti)).Normalize()
+		after := QuatSquadSpline(keys, ti).Inverse().Mul(QuatSquadSpline(keys, ti+h)).Normalize()
+		if !before.OrientationEqualThreshold(after, 1e-4) {
+			t.Errorf("QuatSquadSpline is not smooth at key %d: steps %v and %v", i, before, after)
+		}
+	}
+
+	// With only two keys this is Slerp
+	two := keys[:2]
+	if r, s := QuatSquadSpline(two, 0.3), QuatSlerp(two[0], two[1], 0.3); !r.OrientationEqualThreshold(s, 1e-5) {
+		t.Errorf("QuatSquadSpline of two keys is %v, expected %v", r, s)
+	}
+
+	if r := QuatSquadSpline(keys, 10); !r.OrientationEqualThreshold(keys[4], 1e-5) {
+		t.Errorf("QuatSquadSpline past the end is %v, expected %v", r, keys[4])
+	}
+	if r := QuatSquadSpline(nil, 0.5); r != QuatIdent() {
+		t.Errorf("QuatSquadSpline of no keys is %v, expected identity", r)
+	}
+}
@@ -6,7 +6,10 @@
 
 //#go:generate go run codegen.go -template vector.tmpl -output vector.go
 //#go:generate go run codegen.go -template matrix.tmpl -output matrix.go
+//#go:generate go run codegen.go -template encoding.tmpl -output encoding.go
+//#go:generate go run codegen.go -template parse.tmpl -output parse.go
 //#go:generate go run codegen.go -mgl64
+//#go:generate go run codegen.go -generic
 
 package mgl64
 
@@ -275,6 +275,140 @@ func TestVecEqual(t *testing.T) {
 	assert(!v4.ApproxFuncEqual(errV4, FloatEqual), "Vec4.ApproxFuncEq")
 }
 
+func TestVecIntConversion(t *testing.T) {
+	t.Parallel()
+
+	v := Vec4{1.5, -1.5, 2.2, -0.7}
+	tests := []struct {
+		mode     RoundingMode
+		expected Vec4i
+	}{
+		{RoundFloor, Vec4i{1, -2, 2, -1}},
+		{RoundNearest, Vec4i{2, -2, 2, -1}},
+		{RoundCeil, Vec4i{2, -1, 3, 0}},
+	}
+
+	for _, c := range tests {
+		if r := v.Vec4i(c.mode); r != c.expected {
+			t.Errorf("Vec4i(%v) of %v = %v, expected %v", c.mode, v, r, c.expected)
+		}
+	}
+
+	if r := (Vec2i{3, -4}).Vec2(); r != (Vec2{3, -4}) {
+		t.Errorf("Vec2 of Vec2i{3, -4} = %v", r)
+	}
+	if r := (Vec3{0.5, 1.5, 2.5}).Vec3i(RoundNearest).Vec3(); r != (Vec3{1, 2, 3}) {
+		t.Errorf("Round trip of Vec3{0.5, 1.5, 2.5} = %v, expected %v", r, Vec3{1, 2, 3})
+	}
+}
+
+func TestVecIntOps(t *testing.T) {
+	t.Parallel()
+
+	v1, v2 := Vec3i{1, -5, 3}, Vec3i{4, 2, -2}
+
+	if r := v1.Add(v2); r != (Vec3i{5, -3, 1}) {
+		t.Errorf("%v.Add(%v) = %v", v1, v2, r)
+	}
+	if r := v1.Sub(v2); r != (Vec3i{-3, -7, 5}) {
+		t.Errorf("%v.Sub(%v) = %v", v1, v2, r)
+	}
+	if r := v1.Mul(-2); r != (Vec3i{-2, 10, -6}) {
+		t.Errorf("%v.Mul(-2) = %v", v1, r)
+	}
+	if r := v1.Dot(v2); r != -12 {
+		t.Errorf("%v.Dot(%v) = %v, expected -12", v1, v2, r)
+	}
+	if r := v1.Min(v2); r != (Vec3i{1, -5, -2}) {
+		t.Errorf("%v.Min(%v) = %v", v1, v2, r)
+	}
+	if r := v1.Max(v2); r != (Vec3i{4, 2, 3}) {
+		t.Errorf("%v.Max(%v) = %v", v1, v2, r)
+	}
+	if r := v1.Sub(v2).ManhattanLen(); r != 15 {
+		t.Errorf("Manhattan distance between %v and %v = %v, expected 15", v1, v2, r)
+	}
+	if r := v1.Sub(v2).ChebyshevLen(); r != 7 {
+		t.Errorf("Chebyshev distance between %v and %v = %v, expected 7", v1, v2, r)
+	}
+	if x, y := (Vec2i{7, 8}).Elem(); x != 7 || y != 8 {
+		t.Errorf("Elem of Vec2i{7, 8} = %v, %v", x, y)
+	}
+}
+
+func TestVecElementWise(t *testing.T) {
+	t.Parallel()
+
+	v1, v2 := Vec3{1.5, -2, 4}, Vec3{2, 0.5, -1}
+
+	tests := []struct {
+		name          string
+		got, expected Vec3
+	}{
+		{"MulElem", v1.MulElem(v2), Vec3{3, -1, -4}},
+		{"DivElem", v1.DivElem(v2), Vec3{0.75, -4, -4}},
+		{"Min", v1.Min(v2), Vec3{1.5, -2, -1}},
+		{"Max", v1.Max(v2), Vec3{2, 0.5, 4}},
+		{"Abs", v1.Abs(), Vec3{1.5, 2, 4}},
+		{"Floor", Vec3{1.5, -1.5, 2}.Floor(), Vec3{1, -2, 2}},
+		{"Ceil", Vec3{1.5, -1.5, 2}.Ceil(), Vec3{2, -1, 2}},
+		{"Clamp", v1.Clamp(Vec3{0, -1, 0}, Vec3{1, 1, 5}), Vec3{1, -1, 4}},
+		{"Lerp", v1.Lerp(v2, 0.5), Vec3{1.75, -0.75, 1.5}},
+		{"Step", v1.Step(Vec3{1.5, 0, 5}), Vec3{1, 0, 0}},
+		{"SmoothStep", Vec3{0, 0.5, 2}.SmoothStep(Vec3{0, 0, 0}, Vec3{1, 1, 1}), Vec3{0, 0.5, 1}},
+	}
+
+	for _, c := range tests {
+		if !c.got.ApproxEqual(c.expected) {
+			t.Errorf("%s = %v, expected %v", c.name, c.got, c.expected)
+		}
+	}
+}
+
+func TestVecReflectRefract(t *testing.T) {
+	t.Parallel()
+
+	n := Vec2{0, 1}
+	in := Vec2{1, -1}.Normalize()
+
+	if r := in.Reflect(n); !r.ApproxEqualThreshold(Vec2{1, 1}.Normalize(), 1e-6) {
+		t.Errorf("Reflect of %v off %v = %v", in, n, r)
+	}
+
+	// Snell's law: sin(out) = eta*sin(in)
+	eta := float64(1 / 1.5)
+	r := in.Refract(n, eta)
+	if !FloatEqualThreshold(r.Len(), 1, 1e-6) || !FloatEqualThreshold(r[0], eta*in[0], 1e-6) || r[1] >= 0 {
+		t.Errorf("Refract of %v at %v with eta %v = %v", in, n, eta, r)
+	}
+
+	// Total internal reflection
+	if r := in.Refract(n, 1.5); r != (Vec2{}) {
+		t.Errorf("Refract with total internal reflection = %v, expected zero", r)
+	}
+	if r := (Vec2{0, -1}).Refract(n, 1.5); !r.ApproxEqual(Vec2{0, -1}) {
+		t.Errorf("Refract along the normal = %v, expected %v", r, Vec2{0, -1})
+	}
+}
+
+func TestVecSwizzle(t *testing.T) {
+	t.Parallel()
+
+	v4 := Vec4{1, 2, 3, 4}
+	if r := v4.WZYX(); r != (Vec4{4, 3, 2, 1}) {
+		t.Errorf("WZYX = %v", r)
+	}
+	if r := v4.XZ(); r != (Vec2{1, 3}) {
+		t.Errorf("XZ = %v", r)
+	}
+	if r := (Vec3{1, 2, 3}).ZYX(); r != (Vec3{3, 2, 1}) {
+		t.Errorf("ZYX = %v", r)
+	}
+	if r := (Vec2{5, 6}).YYXX(); r != (Vec4{6, 6, 5, 5}) {
+		t.Errorf("YYXX = %v", r)
+	}
+}
+
 func BenchmarkVec4Add(b *testing.B) {
 	b.StopTimer()
 	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
@@ -369,3 +503,66 @@ func BenchmarkVecCross(b *testing.B) {
 		v1.Cross(v2)
 	}
 }
+
+func BenchmarkTransformCoordinates(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	src := randVec3s(r, 200000)
+	dst := make([]Vec3, len(src))
+	m := Translate3D(1, 2, 3).Mul4(HomogRotate3DX(0.5))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		TransformCoordinates(dst, src, m)
+	}
+}
+
+func BenchmarkTransformCoordinatesLoop(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	src := randVec3s(r, 200000)
+	dst := make([]Vec3, len(src))
+	m := Translate3D(1, 2, 3).Mul4(HomogRotate3DX(0.5))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, v := range src {
+			dst[j] = TransformCoordinate(v, m)
+		}
+	}
+}
+
+func BenchmarkTransformNormals(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	src := randVec3s(r, 200000)
+	dst := make([]Vec3, len(src))
+	m := HomogRotate3DX(0.5)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		TransformNormals(dst, src, m)
+	}
+}
+
+func BenchmarkNormalizeAll(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	vs := randVec3s(r, 200000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NormalizeAll(vs)
+	}
+}
+
+func BenchmarkMulMat4Batch(b *testing.B) {
+	a := make([]Mat4, 256)
+	bs := make([]Mat4, len(a))
+	for i := range a {
+		a[i] = HomogRotate3DY(float64(i))
+		bs[i] = Translate3D(float64(i), 0, 0)
+	}
+	dst := make([]Mat4, len(a))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MulMat4Batch(dst, a, bs)
+	}
+}
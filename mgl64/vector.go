@@ -94,6 +94,13 @@ func (v Vec4) Quat() Quat {
 	return Quat{v[3], Vec3{v[0], v[1], v[2]}}
 }
 
+// Vec2i converts the vector to an integer vector, rounding every element
+// as specified by mode. Elements out of the range of int, and NaNs, give an
+// undefined result.
+func (v Vec2) Vec2i(mode RoundingMode) Vec2i {
+	return Vec2i{roundToInt(v[0], mode), roundToInt(v[1], mode)}
+}
+
 // Add performs element-wise addition between two vectors. It is equivalent to iterating
 // over every element of v1 and adding the corresponding element of v2 to it.
 func (v1 Vec2) Add(v2 Vec2) Vec2 {
@@ -191,6 +198,106 @@ func (v1 Vec2) ApproxFuncEqual(v2 Vec2, eq func(float64, float64) bool) bool {
 	return true
 }
 
+// MulElem performs element-wise multiplication between two vectors, like the
+// * operator on GLSL vectors.
+func (v1 Vec2) MulElem(v2 Vec2) Vec2 {
+	return Vec2{v1[0] * v2[0], v1[1] * v2[1]}
+}
+
+// DivElem performs element-wise division between two vectors, like the /
+// operator on GLSL vectors.
+func (v1 Vec2) DivElem(v2 Vec2) Vec2 {
+	return Vec2{v1[0] / v2[0], v1[1] / v2[1]}
+}
+
+// Min returns the element-wise minimum of the two vectors.
+func (v1 Vec2) Min(v2 Vec2) Vec2 {
+	for i := range v1 {
+		SetMin(&v1[i], &v2[i])
+	}
+	return v1
+}
+
+// Max returns the element-wise maximum of the two vectors.
+func (v1 Vec2) Max(v2 Vec2) Vec2 {
+	for i := range v1 {
+		SetMax(&v1[i], &v2[i])
+	}
+	return v1
+}
+
+// Abs returns the vector with the absolute value of every element.
+func (v1 Vec2) Abs() Vec2 {
+	return Vec2{Abs(v1[0]), Abs(v1[1])}
+}
+
+// Floor returns the vector with every element rounded down.
+func (v1 Vec2) Floor() Vec2 {
+	return Vec2{float64(math.Floor(float64(v1[0]))), float64(math.Floor(float64(v1[1])))}
+}
+
+// Ceil returns the vector with every element rounded up.
+func (v1 Vec2) Ceil() Vec2 {
+	return Vec2{float64(math.Ceil(float64(v1[0]))), float64(math.Ceil(float64(v1[1])))}
+}
+
+// Clamp clamps every element of the vector to the range given by the matching
+// elements of low and high, as with Clamp.
+func (v1 Vec2) Clamp(low, high Vec2) Vec2 {
+	return Vec2{Clamp(v1[0], low[0], high[0]), Clamp(v1[1], low[1], high[1])}
+}
+
+// Lerp linearly interpolates between v1 and v2, returning v1 for t = 0 and v2
+// for t = 1. This is GLSL's mix.
+func (v1 Vec2) Lerp(v2 Vec2, t float64) Vec2 {
+	return Vec2{v1[0] + (v2[0]-v1[0])*t, v1[1] + (v2[1]-v1[1])*t}
+}
+
+// Step returns, for every element, 0 if it is less than the matching element
+// of edge, and 1 otherwise, like GLSL's step(edge, v1).
+func (v1 Vec2) Step(edge Vec2) Vec2 {
+	var res Vec2
+	for i := range v1 {
+		if v1[i] >= edge[i] {
+			res[i] = 1
+		}
+	}
+	return res
+}
+
+// SmoothStep performs, for every element, smooth Hermite interpolation
+// between 0 and 1 as it goes from the matching element of edge0 to that of
+// edge1, like GLSL's smoothstep(edge0, edge1, v1). The result is undefined if
+// an element of edge0 is not less than that of edge1.
+func (v1 Vec2) SmoothStep(edge0, edge1 Vec2) Vec2 {
+	var res Vec2
+	for i := range v1 {
+		t := Clamp((v1[i]-edge0[i])/(edge1[i]-edge0[i]), 0, 1)
+		res[i] = t * t * (3 - 2*t)
+	}
+	return res
+}
+
+// Reflect returns the direction of the incident vector v1 reflected off a
+// surface with the normal n, v1 - 2*Dot(n, v1)*n. n should be normalized.
+func (v1 Vec2) Reflect(n Vec2) Vec2 {
+	return v1.Sub(n.Mul(2 * n.Dot(v1)))
+}
+
+// Refract returns the direction of the incident vector v1 refracted at a
+// surface with the normal n, where eta is the ratio of the indices of
+// refraction (that of the medium v1 comes from over that of the medium it
+// enters). Like GLSL's refract, v1 and n should be normalized, and on total
+// internal reflection this returns the zero vector.
+func (v1 Vec2) Refract(n Vec2, eta float64) Vec2 {
+	d := n.Dot(v1)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vec2{}
+	}
+	return v1.Mul(eta).Sub(n.Mul(eta*d + float64(math.Sqrt(float64(k)))))
+}
+
 // X is an element access func, it is equivalent to v[n] where
 // n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
 // show that this is more or less as fast as direct acces, probably due to
@@ -207,6 +314,174 @@ func (v Vec2) Y() float64 {
 	return v[1]
 }
 
+// XX returns a vector made of the elements XX of this one, like
+// the GLSL swizzle v.xx.
+func (v Vec2) XX() Vec2 {
+	return Vec2{v[0], v[0]}
+}
+
+// XY returns a vector made of the elements XY of this one, like
+// the GLSL swizzle v.xy.
+func (v Vec2) XY() Vec2 {
+	return Vec2{v[0], v[1]}
+}
+
+// YX returns a vector made of the elements YX of this one, like
+// the GLSL swizzle v.yx.
+func (v Vec2) YX() Vec2 {
+	return Vec2{v[1], v[0]}
+}
+
+// YY returns a vector made of the elements YY of this one, like
+// the GLSL swizzle v.yy.
+func (v Vec2) YY() Vec2 {
+	return Vec2{v[1], v[1]}
+}
+
+// XXX returns a vector made of the elements XXX of this one, like
+// the GLSL swizzle v.xxx.
+func (v Vec2) XXX() Vec3 {
+	return Vec3{v[0], v[0], v[0]}
+}
+
+// XXY returns a vector made of the elements XXY of this one, like
+// the GLSL swizzle v.xxy.
+func (v Vec2) XXY() Vec3 {
+	return Vec3{v[0], v[0], v[1]}
+}
+
+// XYX returns a vector made of the elements XYX of this one, like
+// the GLSL swizzle v.xyx.
+func (v Vec2) XYX() Vec3 {
+	return Vec3{v[0], v[1], v[0]}
+}
+
+// XYY returns a vector made of the elements XYY of this one, like
+// the GLSL swizzle v.xyy.
+func (v Vec2) XYY() Vec3 {
+	return Vec3{v[0], v[1], v[1]}
+}
+
+// YXX returns a vector made of the elements YXX of this one, like
+// the GLSL swizzle v.yxx.
+func (v Vec2) YXX() Vec3 {
+	return Vec3{v[1], v[0], v[0]}
+}
+
+// YXY returns a vector made of the elements YXY of this one, like
+// the GLSL swizzle v.yxy.
+func (v Vec2) YXY() Vec3 {
+	return Vec3{v[1], v[0], v[1]}
+}
+
+// YYX returns a vector made of the elements YYX of this one, like
+// the GLSL swizzle v.yyx.
+func (v Vec2) YYX() Vec3 {
+	return Vec3{v[1], v[1], v[0]}
+}
+
+// YYY returns a vector made of the elements YYY of this one, like
+// the GLSL swizzle v.yyy.
+func (v Vec2) YYY() Vec3 {
+	return Vec3{v[1], v[1], v[1]}
+}
+
+// XXXX returns a vector made of the elements XXXX of this one, like
+// the GLSL swizzle v.xxxx.
+func (v Vec2) XXXX() Vec4 {
+	return Vec4{v[0], v[0], v[0], v[0]}
+}
+
+// XXXY returns a vector made of the elements XXXY of this one, like
+// the GLSL swizzle v.xxxy.
+func (v Vec2) XXXY() Vec4 {
+	return Vec4{v[0], v[0], v[0], v[1]}
+}
+
+// XXYX returns a vector made of the elements XXYX of this one, like
+// the GLSL swizzle v.xxyx.
+func (v Vec2) XXYX() Vec4 {
+	return Vec4{v[0], v[0], v[1], v[0]}
+}
+
+// XXYY returns a vector made of the elements XXYY of this one, like
+// the GLSL swizzle v.xxyy.
+func (v Vec2) XXYY() Vec4 {
+	return Vec4{v[0], v[0], v[1], v[1]}
+}
+
+// XYXX returns a vector made of the elements XYXX of this one, like
+// the GLSL swizzle v.xyxx.
+func (v Vec2) XYXX() Vec4 {
+	return Vec4{v[0], v[1], v[0], v[0]}
+}
+
+// XYXY returns a vector made of the elements XYXY of this one, like
+// the GLSL swizzle v.xyxy.
+func (v Vec2) XYXY() Vec4 {
+	return Vec4{v[0], v[1], v[0], v[1]}
+}
+
+// XYYX returns a vector made of the elements XYYX of this one, like
+// the GLSL swizzle v.xyyx.
+func (v Vec2) XYYX() Vec4 {
+	return Vec4{v[0], v[1], v[1], v[0]}
+}
+
+// XYYY returns a vector made of the elements XYYY of this one, like
+// the GLSL swizzle v.xyyy.
+func (v Vec2) XYYY() Vec4 {
+	return Vec4{v[0], v[1], v[1], v[1]}
+}
+
+// YXXX returns a vector made of the elements YXXX of this one, like
+// the GLSL swizzle v.yxxx.
+func (v Vec2) YXXX() Vec4 {
+	return Vec4{v[1], v[0], v[0], v[0]}
+}
+
+// YXXY returns a vector made of the elements YXXY of this one, like
+// the GLSL swizzle v.yxxy.
+func (v Vec2) YXXY() Vec4 {
+	return Vec4{v[1], v[0], v[0], v[1]}
+}
+
+// YXYX returns a vector made of the elements YXYX of this one, like
+// the GLSL swizzle v.yxyx.
+func (v Vec2) YXYX() Vec4 {
+	return Vec4{v[1], v[0], v[1], v[0]}
+}
+
+// YXYY returns a vector made of the elements YXYY of this one, like
+// the GLSL swizzle v.yxyy.
+func (v Vec2) YXYY() Vec4 {
+	return Vec4{v[1], v[0], v[1], v[1]}
+}
+
+// YYXX returns a vector made of the elements YYXX of this one, like
+// the GLSL swizzle v.yyxx.
+func (v Vec2) YYXX() Vec4 {
+	return Vec4{v[1], v[1], v[0], v[0]}
+}
+
+// YYXY returns a vector made of the elements YYXY of this one, like
+// the GLSL swizzle v.yyxy.
+func (v Vec2) YYXY() Vec4 {
+	return Vec4{v[1], v[1], v[0], v[1]}
+}
+
+// YYYX returns a vector made of the elements YYYX of this one, like
+// the GLSL swizzle v.yyyx.
+func (v Vec2) YYYX() Vec4 {
+	return Vec4{v[1], v[1], v[1], v[0]}
+}
+
+// YYYY returns a vector made of the elements YYYY of this one, like
+// the GLSL swizzle v.yyyy.
+func (v Vec2) YYYY() Vec4 {
+	return Vec4{v[1], v[1], v[1], v[1]}
+}
+
 // OuterProd2 does the vector outer product
 // of two vectors. The outer product produces an
 // 2x2 matrix. E.G. a Vec2 * Vec2 = Mat2.
@@ -252,6 +527,13 @@ func (v1 Vec2) OuterProd4(v2 Vec4) Mat2x4 {
 	return Mat2x4{v1[0] * v2[0], v1[1] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[0] * v2[2], v1[1] * v2[2], v1[0] * v2[3], v1[1] * v2[3]}
 }
 
+// Vec3i converts the vector to an integer vector, rounding every element
+// as specified by mode. Elements out of the range of int, and NaNs, give an
+// undefined result.
+func (v Vec3) Vec3i(mode RoundingMode) Vec3i {
+	return Vec3i{roundToInt(v[0], mode), roundToInt(v[1], mode), roundToInt(v[2], mode)}
+}
+
 // Add performs element-wise addition between two vectors. It is equivalent to iterating
 // over every element of v1 and adding the corresponding element of v2 to it.
 func (v1 Vec3) Add(v2 Vec3) Vec3 {
@@ -349,177 +631,111 @@ func (v1 Vec3) ApproxFuncEqual(v2 Vec3, eq func(float64, float64) bool) bool {
 	return true
 }
 
-// X is an element access func, it is equivalent to v[n] where
-// n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
-// show that this is more or less as fast as direct acces, probably due to
-// inlining, so use v[0] or v.X() depending on personal preference.
-func (v Vec3) X() float64 {
-	return v[0]
-}
-
-// Y is an element access func, it is equivalent to v[n] where
-// n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
-// show that this is more or less as fast as direct acces, probably due to
-// inlining, so use v[0] or v.X() depending on personal preference.
-func (v Vec3) Y() float64 {
-	return v[1]
-}
-
-// Z is an element access func, it is equivalent to v[n] where
-// n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
-// show that this is more or less as fast as direct acces, probably due to
-// inlining, so use v[0] or v.X() depending on personal preference.
-func (v Vec3) Z() float64 {
-	return v[2]
-}
-
-// OuterProd2 does the vector outer product
-// of two vectors. The outer product produces an
-// 3x2 matrix. E.G. a Vec3 * Vec2 = Mat3x2.
-//
-// The outer product can be thought of as the "opposite"
-// of the Dot product. The Dot product treats both vectors like matrices
-// oriented such that the left one has N columns and the right has N rows.
-// So Vec3.Vec3 = Mat1x3*Mat3x1 = Mat1 = Scalar.
-//
-// The outer product orients it so they're facing "outward": Vec2*Vec3
-// = Mat2x1*Mat1x3 = Mat2x3.
-func (v1 Vec3) OuterProd2(v2 Vec2) Mat3x2 {
-	return Mat3x2{v1[0] * v2[0], v1[1] * v2[0], v1[2] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[2] * v2[1]}
-}
-
-// OuterProd3 does the vector outer product
-// of two vectors. The outer product produces an
-// 3x3 matrix. E.G. a Vec3 * Vec3 = Mat3.
-//
-// The outer product can be thought of as the "opposite"
-// of the Dot product. The Dot product treats both vectors like matrices
-// oriented such that the left one has N columns and the right has N rows.
-// So Vec3.Vec3 = Mat1x3*Mat3x1 = Mat1 = Scalar.
-//
-// The outer product orients it so they're facing "outward": Vec2*Vec3
-// = Mat2x1*Mat1x3 = Mat2x3.
-func (v1 Vec3) OuterProd3(v2 Vec3) Mat3 {
-	return Mat3{v1[0] * v2[0], v1[1] * v2[0], v1[2] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[2] * v2[1], v1[0] * v2[2], v1[1] * v2[2], v1[2] * v2[2]}
+// MulElem performs element-wise multiplication between two vectors, like the
+// * operator on GLSL vectors.
+func (v1 Vec3) MulElem(v2 Vec3) Vec3 {
+	return Vec3{v1[0] * v2[0], v1[1] * v2[1], v1[2] * v2[2]}
 }
 
-// OuterProd4 does the vector outer product
-// of two vectors. The outer product produces an
-// 3x4 matrix. E.G. a Vec3 * Vec4 = Mat3x4.
-//
-// The outer product can be thought of as the "opposite"
-// of the Dot product. The Dot product treats both vectors like matrices
-// oriented such that the left one has N columns and the right has N rows.
-// So Vec3.Vec3 = Mat1x3*Mat3x1 = Mat1 = Scalar.
-//
-// The outer product orients it so they're facing "outward": Vec2*Vec3
-// = Mat2x1*Mat1x3 = Mat2x3.
-func (v1 Vec3) OuterProd4(v2 Vec4) Mat3x4 {
-	return Mat3x4{v1[0] * v2[0], v1[1] * v2[0], v1[2] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[2] * v2[1], v1[0] * v2[2], v1[1] * v2[2], v1[2] * v2[2], v1[0] * v2[3], v1[1] * v2[3], v1[2] * v2[3]}
+// DivElem performs element-wise division between two vectors, like the /
+// operator on GLSL vectors.
+func (v1 Vec3) DivElem(v2 Vec3) Vec3 {
+	return Vec3{v1[0] / v2[0], v1[1] / v2[1], v1[2] / v2[2]}
 }
 
-// Add performs element-wise addition between two vectors. It is equivalent to iterating
-// over every element of v1 and adding the corresponding element of v2 to it.
-func (v1 Vec4) Add(v2 Vec4) Vec4 {
-	return Vec4{v1[0] + v2[0], v1[1] + v2[1], v1[2] + v2[2], v1[3] + v2[3]}
+// Min returns the element-wise minimum of the two vectors.
+func (v1 Vec3) Min(v2 Vec3) Vec3 {
+	for i := range v1 {
+		SetMin(&v1[i], &v2[i])
+	}
+	return v1
 }
 
-// Sub performs element-wise subtraction between two vectors. It is equivalent to iterating
-// over every element of v1 and subtracting the corresponding element of v2 from it.
-func (v1 Vec4) Sub(v2 Vec4) Vec4 {
-	return Vec4{v1[0] - v2[0], v1[1] - v2[1], v1[2] - v2[2], v1[3] - v2[3]}
+// Max returns the element-wise maximum of the two vectors.
+func (v1 Vec3) Max(v2 Vec3) Vec3 {
+	for i := range v1 {
+		SetMax(&v1[i], &v2[i])
+	}
+	return v1
 }
 
-// Mul performs a scalar multiplication between the vector and some constant value
-// c. This is equivalent to iterating over every vector element and multiplying by c.
-func (v1 Vec4) Mul(c float64) Vec4 {
-	return Vec4{v1[0] * c, v1[1] * c, v1[2] * c, v1[3] * c}
+// Abs returns the vector with the absolute value of every element.
+func (v1 Vec3) Abs() Vec3 {
+	return Vec3{Abs(v1[0]), Abs(v1[1]), Abs(v1[2])}
 }
 
-// Dot returns the dot product of this vector with another. There are multiple ways
-// to describe this value. One is the multiplication of their lengths and cos(theta) where
-// theta is the angle between the vectors: v1.v2 = |v1||v2|cos(theta).
-//
-// The other (and what is actually done) is the sum of the element-wise multiplication of all
-// elements. So for instance, two Vec3s would yield v1.x * v2.x + v1.y * v2.y + v1.z * v2.z.
-//
-// This means that the dot product of a vector and itself is the square of its Len (within
-// the bounds of floating points error).
-//
-// The dot product is roughly a measure of how closely two vectors are to pointing in the same
-// direction. If both vectors are normalized, the value will be -1 for opposite pointing,
-// one for same pointing, and 0 for perpendicular vectors.
-func (v1 Vec4) Dot(v2 Vec4) float64 {
-	return v1[0]*v2[0] + v1[1]*v2[1] + v1[2]*v2[2] + v1[3]*v2[3]
+// Floor returns the vector with every element rounded down.
+func (v1 Vec3) Floor() Vec3 {
+	return Vec3{float64(math.Floor(float64(v1[0]))), float64(math.Floor(float64(v1[1]))), float64(math.Floor(float64(v1[2])))}
 }
 
-// Len returns the vector's length. Note that this is NOT the dimension of
-// the vector (len(v)), but the mathematical length. This is equivalent to the square
-// root of the sum of the squares of all elements. E.G. for a Vec2 it's
-// math.Hypot(v[0], v[1]).
-func (v1 Vec4) Len() float64 {
-
-	return float64(math.Sqrt(float64(v1[0]*v1[0] + v1[1]*v1[1] + v1[2]*v1[2] + v1[3]*v1[3])))
-
+// Ceil returns the vector with every element rounded up.
+func (v1 Vec3) Ceil() Vec3 {
+	return Vec3{float64(math.Ceil(float64(v1[0]))), float64(math.Ceil(float64(v1[1]))), float64(math.Ceil(float64(v1[2])))}
 }
 
-// LenSqr returns the vector's square length. This is equivalent to the sum of the squares of all elements.
-func (v1 Vec4) LenSqr() float64 {
-	return v1[0]*v1[0] + v1[1]*v1[1] + v1[2]*v1[2] + v1[3]*v1[3]
+// Clamp clamps every element of the vector to the range given by the matching
+// elements of low and high, as with Clamp.
+func (v1 Vec3) Clamp(low, high Vec3) Vec3 {
+	return Vec3{Clamp(v1[0], low[0], high[0]), Clamp(v1[1], low[1], high[1]), Clamp(v1[2], low[2], high[2])}
 }
 
-// Normalize normalizes the vector. Normalization is (1/|v|)*v,
-// making this equivalent to v.Scale(1/v.Len()). If the len is 0.0,
-// this function will return an infinite value for all elements due
-// to how floating point division works in Go (n/0.0 = math.Inf(Sign(n))).
-//
-// Normalization makes a vector's Len become 1.0 (within the margin of floating point error),
-// while maintaining its directionality.
-//
-// (Can be seen here: http://play.golang.org/p/Aaj7SnbqIp )
-func (v1 Vec4) Normalize() Vec4 {
-	l := 1.0 / v1.Len()
-	return Vec4{v1[0] * l, v1[1] * l, v1[2] * l, v1[3] * l}
+// Lerp linearly interpolates between v1 and v2, returning v1 for t = 0 and v2
+// for t = 1. This is GLSL's mix.
+func (v1 Vec3) Lerp(v2 Vec3, t float64) Vec3 {
+	return Vec3{v1[0] + (v2[0]-v1[0])*t, v1[1] + (v2[1]-v1[1])*t, v1[2] + (v2[2]-v1[2])*t}
 }
 
-// ApproxEqual takes in a vector and does an element-wise approximate float
-// comparison as if FloatEqual had been used
-func (v1 Vec4) ApproxEqual(v2 Vec4) bool {
+// Step returns, for every element, 0 if it is less than the matching element
+// of edge, and 1 otherwise, like GLSL's step(edge, v1).
+func (v1 Vec3) Step(edge Vec3) Vec3 {
+	var res Vec3
 	for i := range v1 {
-		if !FloatEqual(v1[i], v2[i]) {
-			return false
+		if v1[i] >= edge[i] {
+			res[i] = 1
 		}
 	}
-	return true
+	return res
 }
 
-// ApproxEqualThreshold takes in a threshold for comparing two floats, and uses
-// it to do an element-wise comparison of the vector to another.
-func (v1 Vec4) ApproxEqualThreshold(v2 Vec4, threshold float64) bool {
+// SmoothStep performs, for every element, smooth Hermite interpolation
+// between 0 and 1 as it goes from the matching element of edge0 to that of
+// edge1, like GLSL's smoothstep(edge0, edge1, v1). The result is undefined if
+// an element of edge0 is not less than that of edge1.
+func (v1 Vec3) SmoothStep(edge0, edge1 Vec3) Vec3 {
+	var res Vec3
 	for i := range v1 {
-		if !FloatEqualThreshold(v1[i], v2[i], threshold) {
-			return false
-		}
+		t := Clamp((v1[i]-edge0[i])/(edge1[i]-edge0[i]), 0, 1)
+		res[i] = t * t * (3 - 2*t)
 	}
-	return true
+	return res
 }
 
-// ApproxFuncEqual takes in a func that compares two floats, and uses it to do an element-wise
-// comparison of the vector to another. This is intended to be used with FloatEqualFunc
-func (v1 Vec4) ApproxFuncEqual(v2 Vec4, eq func(float64, float64) bool) bool {
-	for i := range v1 {
-		if !eq(v1[i], v2[i]) {
-			return false
-		}
+// Reflect returns the direction of the incident vector v1 reflected off a
+// surface with the normal n, v1 - 2*Dot(n, v1)*n. n should be normalized.
+func (v1 Vec3) Reflect(n Vec3) Vec3 {
+	return v1.Sub(n.Mul(2 * n.Dot(v1)))
+}
+
+// Refract returns the direction of the incident vector v1 refracted at a
+// surface with the normal n, where eta is the ratio of the indices of
+// refraction (that of the medium v1 comes from over that of the medium it
+// enters). Like GLSL's refract, v1 and n should be normalized, and on total
+// internal reflection this returns the zero vector.
+func (v1 Vec3) Refract(n Vec3, eta float64) Vec3 {
+	d := n.Dot(v1)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vec3{}
 	}
-	return true
+	return v1.Mul(eta).Sub(n.Mul(eta*d + float64(math.Sqrt(float64(k)))))
 }
 
 // X is an element access func, it is equivalent to v[n] where
 // n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
 // show that this is more or less as fast as direct acces, probably due to
 // inlining, so use v[0] or v.X() depending on personal preference.
-func (v Vec4) X() float64 {
+func (v Vec3) X() float64 {
 	return v[0]
 }
 
@@ -527,7 +743,7 @@ func (v Vec4) X() float64 {
 // n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
 // show that this is more or less as fast as direct acces, probably due to
 // inlining, so use v[0] or v.X() depending on personal preference.
-func (v Vec4) Y() float64 {
+func (v Vec3) Y() float64 {
 	return v[1]
 }
 
@@ -535,23 +751,3014 @@ func (v Vec4) Y() float64 {
 // n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
 // show that this is more or less as fast as direct acces, probably due to
 // inlining, so use v[0] or v.X() depending on personal preference.
-func (v Vec4) Z() float64 {
+func (v Vec3) Z() float64 {
 	return v[2]
 }
 
-// W is an element access func, it is equivalent to v[n] where
-// n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
-// show that this is more or less as fast as direct acces, probably due to
-// inlining, so use v[0] or v.X() depending on personal preference.
-func (v Vec4) W() float64 {
-	return v[3]
+// XX returns a vector made of the elements XX of this one, like
+// the GLSL swizzle v.xx.
+func (v Vec3) XX() Vec2 {
+	return Vec2{v[0], v[0]}
 }
 
-// OuterProd2 does the vector outer product
-// of two vectors. The outer product produces an
-// 4x2 matrix. E.G. a Vec4 * Vec2 = Mat4x2.
-//
-// The outer product can be thought of as the "opposite"
+// XY returns a vector made of the elements XY of this one, like
+// the GLSL swizzle v.xy.
+func (v Vec3) XY() Vec2 {
+	return Vec2{v[0], v[1]}
+}
+
+// XZ returns a vector made of the elements XZ of this one, like
+// the GLSL swizzle v.xz.
+func (v Vec3) XZ() Vec2 {
+	return Vec2{v[0], v[2]}
+}
+
+// YX returns a vector made of the elements YX of this one, like
+// the GLSL swizzle v.yx.
+func (v Vec3) YX() Vec2 {
+	return Vec2{v[1], v[0]}
+}
+
+// YY returns a vector made of the elements YY of this one, like
+// the GLSL swizzle v.yy.
+func (v Vec3) YY() Vec2 {
+	return Vec2{v[1], v[1]}
+}
+
+// YZ returns a vector made of the elements YZ of this one, like
+// the GLSL swizzle v.yz.
+func (v Vec3) YZ() Vec2 {
+	return Vec2{v[1], v[2]}
+}
+
+// ZX returns a vector made of the elements ZX of this one, like
+// the GLSL swizzle v.zx.
+func (v Vec3) ZX() Vec2 {
+	return Vec2{v[2], v[0]}
+}
+
+// ZY returns a vector made of the elements ZY of this one, like
+// the GLSL swizzle v.zy.
+func (v Vec3) ZY() Vec2 {
+	return Vec2{v[2], v[1]}
+}
+
+// ZZ returns a vector made of the elements ZZ of this one, like
+// the GLSL swizzle v.zz.
+func (v Vec3) ZZ() Vec2 {
+	return Vec2{v[2], v[2]}
+}
+
+// XXX returns a vector made of the elements XXX of this one, like
+// the GLSL swizzle v.xxx.
+func (v Vec3) XXX() Vec3 {
+	return Vec3{v[0], v[0], v[0]}
+}
+
+// XXY returns a vector made of the elements XXY of this one, like
+// the GLSL swizzle v.xxy.
+func (v Vec3) XXY() Vec3 {
+	return Vec3{v[0], v[0], v[1]}
+}
+
+// XXZ returns a vector made of the elements XXZ of this one, like
+// the GLSL swizzle v.xxz.
+func (v Vec3) XXZ() Vec3 {
+	return Vec3{v[0], v[0], v[2]}
+}
+
+// XYX returns a vector made of the elements XYX of this one, like
+// the GLSL swizzle v.xyx.
+func (v Vec3) XYX() Vec3 {
+	return Vec3{v[0], v[1], v[0]}
+}
+
+// XYY returns a vector made of the elements XYY of this one, like
+// the GLSL swizzle v.xyy.
+func (v Vec3) XYY() Vec3 {
+	return Vec3{v[0], v[1], v[1]}
+}
+
+// XYZ returns a vector made of the elements XYZ of this one, like
+// the GLSL swizzle v.xyz.
+func (v Vec3) XYZ() Vec3 {
+	return Vec3{v[0], v[1], v[2]}
+}
+
+// XZX returns a vector made of the elements XZX of this one, like
+// the GLSL swizzle v.xzx.
+func (v Vec3) XZX() Vec3 {
+	return Vec3{v[0], v[2], v[0]}
+}
+
+// XZY returns a vector made of the elements XZY of this one, like
+// the GLSL swizzle v.xzy.
+func (v Vec3) XZY() Vec3 {
+	return Vec3{v[0], v[2], v[1]}
+}
+
+// XZZ returns a vector made of the elements XZZ of this one, like
+// the GLSL swizzle v.xzz.
+func (v Vec3) XZZ() Vec3 {
+	return Vec3{v[0], v[2], v[2]}
+}
+
+// YXX returns a vector made of the elements YXX of this one, like
+// the GLSL swizzle v.yxx.
+func (v Vec3) YXX() Vec3 {
+	return Vec3{v[1], v[0], v[0]}
+}
+
+// YXY returns a vector made of the elements YXY of this one, like
+// the GLSL swizzle v.yxy.
+func (v Vec3) YXY() Vec3 {
+	return Vec3{v[1], v[0], v[1]}
+}
+
+// YXZ returns a vector made of the elements YXZ of this one, like
+// the GLSL swizzle v.yxz.
+func (v Vec3) YXZ() Vec3 {
+	return Vec3{v[1], v[0], v[2]}
+}
+
+// YYX returns a vector made of the elements YYX of this one, like
+// the GLSL swizzle v.yyx.
+func (v Vec3) YYX() Vec3 {
+	return Vec3{v[1], v[1], v[0]}
+}
+
+// YYY returns a vector made of the elements YYY of this one, like
+// the GLSL swizzle v.yyy.
+func (v Vec3) YYY() Vec3 {
+	return Vec3{v[1], v[1], v[1]}
+}
+
+// YYZ returns a vector made of the elements YYZ of this one, like
+// the GLSL swizzle v.yyz.
+func (v Vec3) YYZ() Vec3 {
+	return Vec3{v[1], v[1], v[2]}
+}
+
+// YZX returns a vector made of the elements YZX of this one, like
+// the GLSL swizzle v.yzx.
+func (v Vec3) YZX() Vec3 {
+	return Vec3{v[1], v[2], v[0]}
+}
+
+// YZY returns a vector made of the elements YZY of this one, like
+// the GLSL swizzle v.yzy.
+func (v Vec3) YZY() Vec3 {
+	return Vec3{v[1], v[2], v[1]}
+}
+
+// YZZ returns a vector made of the elements YZZ of this one, like
+// the GLSL swizzle v.yzz.
+func (v Vec3) YZZ() Vec3 {
+	return Vec3{v[1], v[2], v[2]}
+}
+
+// ZXX returns a vector made of the elements ZXX of this one, like
+// the GLSL swizzle v.zxx.
+func (v Vec3) ZXX() Vec3 {
+	return Vec3{v[2], v[0], v[0]}
+}
+
+// ZXY returns a vector made of the elements ZXY of this one, like
+// the GLSL swizzle v.zxy.
+func (v Vec3) ZXY() Vec3 {
+	return Vec3{v[2], v[0], v[1]}
+}
+
+// ZXZ returns a vector made of the elements ZXZ of this one, like
+// the GLSL swizzle v.zxz.
+func (v Vec3) ZXZ() Vec3 {
+	return Vec3{v[2], v[0], v[2]}
+}
+
+// ZYX returns a vector made of the elements ZYX of this one, like
+// the GLSL swizzle v.zyx.
+func (v Vec3) ZYX() Vec3 {
+	return Vec3{v[2], v[1], v[0]}
+}
+
+// ZYY returns a vector made of the elements ZYY of this one, like
+// the GLSL swizzle v.zyy.
+func (v Vec3) ZYY() Vec3 {
+	return Vec3{v[2], v[1], v[1]}
+}
+
+// ZYZ returns a vector made of the elements ZYZ of this one, like
+// the GLSL swizzle v.zyz.
+func (v Vec3) ZYZ() Vec3 {
+	return Vec3{v[2], v[1], v[2]}
+}
+
+// ZZX returns a vector made of the elements ZZX of this one, like
+// the GLSL swizzle v.zzx.
+func (v Vec3) ZZX() Vec3 {
+	return Vec3{v[2], v[2], v[0]}
+}
+
+// ZZY returns a vector made of the elements ZZY of this one, like
+// the GLSL swizzle v.zzy.
+func (v Vec3) ZZY() Vec3 {
+	return Vec3{v[2], v[2], v[1]}
+}
+
+// ZZZ returns a vector made of the elements ZZZ of this one, like
+// the GLSL swizzle v.zzz.
+func (v Vec3) ZZZ() Vec3 {
+	return Vec3{v[2], v[2], v[2]}
+}
+
+// XXXX returns a vector made of the elements XXXX of this one, like
+// the GLSL swizzle v.xxxx.
+func (v Vec3) XXXX() Vec4 {
+	return Vec4{v[0], v[0], v[0], v[0]}
+}
+
+// XXXY returns a vector made of the elements XXXY of this one, like
+// the GLSL swizzle v.xxxy.
+func (v Vec3) XXXY() Vec4 {
+	return Vec4{v[0], v[0], v[0], v[1]}
+}
+
+// XXXZ returns a vector made of the elements XXXZ of this one, like
+// the GLSL swizzle v.xxxz.
+func (v Vec3) XXXZ() Vec4 {
+	return Vec4{v[0], v[0], v[0], v[2]}
+}
+
+// XXYX returns a vector made of the elements XXYX of this one, like
+// the GLSL swizzle v.xxyx.
+func (v Vec3) XXYX() Vec4 {
+	return Vec4{v[0], v[0], v[1], v[0]}
+}
+
+// XXYY returns a vector made of the elements XXYY of this one, like
+// the GLSL swizzle v.xxyy.
+func (v Vec3) XXYY() Vec4 {
+	return Vec4{v[0], v[0], v[1], v[1]}
+}
+
+// XXYZ returns a vector made of the elements XXYZ of this one, like
+// the GLSL swizzle v.xxyz.
+func (v Vec3) XXYZ() Vec4 {
+	return Vec4{v[0], v[0], v[1], v[2]}
+}
+
+// XXZX returns a vector made of the elements XXZX of this one, like
+// the GLSL swizzle v.xxzx.
+func (v Vec3) XXZX() Vec4 {
+	return Vec4{v[0], v[0], v[2], v[0]}
+}
+
+// XXZY returns a vector made of the elements XXZY of this one, like
+// the GLSL swizzle v.xxzy.
+func (v Vec3) XXZY() Vec4 {
+	return Vec4{v[0], v[0], v[2], v[1]}
+}
+
+// XXZZ returns a vector made of the elements XXZZ of this one, like
+// the GLSL swizzle v.xxzz.
+func (v Vec3) XXZZ() Vec4 {
+	return Vec4{v[0], v[0], v[2], v[2]}
+}
+
+// XYXX returns a vector made of the elements XYXX of this one, like
+// the GLSL swizzle v.xyxx.
+func (v Vec3) XYXX() Vec4 {
+	return Vec4{v[0], v[1], v[0], v[0]}
+}
+
+// XYXY returns a vector made of the elements XYXY of this one, like
+// the GLSL swizzle v.xyxy.
+func (v Vec3) XYXY() Vec4 {
+	return Vec4{v[0], v[1], v[0], v[1]}
+}
+
+// XYXZ returns a vector made of the elements XYXZ of this one, like
+// the GLSL swizzle v.xyxz.
+func (v Vec3) XYXZ() Vec4 {
+	return Vec4{v[0], v[1], v[0], v[2]}
+}
+
+// XYYX returns a vector made of the elements XYYX of this one, like
+// the GLSL swizzle v.xyyx.
+func (v Vec3) XYYX() Vec4 {
+	return Vec4{v[0], v[1], v[1], v[0]}
+}
+
+// XYYY returns a vector made of the elements XYYY of this one, like
+// the GLSL swizzle v.xyyy.
+func (v Vec3) XYYY() Vec4 {
+	return Vec4{v[0], v[1], v[1], v[1]}
+}
+
+// XYYZ returns a vector made of the elements XYYZ of this one, like
+// the GLSL swizzle v.xyyz.
+func (v Vec3) XYYZ() Vec4 {
+	return Vec4{v[0], v[1], v[1], v[2]}
+}
+
+// XYZX returns a vector made of the elements XYZX of this one, like
+// the GLSL swizzle v.xyzx.
+func (v Vec3) XYZX() Vec4 {
+	return Vec4{v[0], v[1], v[2], v[0]}
+}
+
+// XYZY returns a vector made of the elements XYZY of this one, like
+// the GLSL swizzle v.xyzy.
+func (v Vec3) XYZY() Vec4 {
+	return Vec4{v[0], v[1], v[2], v[1]}
+}
+
+// XYZZ returns a vector made of the elements XYZZ of this one, like
+// the GLSL swizzle v.xyzz.
+func (v Vec3) XYZZ() Vec4 {
+	return Vec4{v[0], v[1], v[2], v[2]}
+}
+
+// XZXX returns a vector made of the elements XZXX of this one, like
+// the GLSL swizzle v.xzxx.
+func (v Vec3) XZXX() Vec4 {
+	return Vec4{v[0], v[2], v[0], v[0]}
+}
+
+// XZXY returns a vector made of the elements XZXY of this one, like
+// the GLSL swizzle v.xzxy.
+func (v Vec3) XZXY() Vec4 {
+	return Vec4{v[0], v[2], v[0], v[1]}
+}
+
+// XZXZ returns a vector made of the elements XZXZ of this one, like
+// the GLSL swizzle v.xzxz.
+func (v Vec3) XZXZ() Vec4 {
+	return Vec4{v[0], v[2], v[0], v[2]}
+}
+
+// XZYX returns a vector made of the elements XZYX of this one, like
+// the GLSL swizzle v.xzyx.
+func (v Vec3) XZYX() Vec4 {
+	return Vec4{v[0], v[2], v[1], v[0]}
+}
+
+// XZYY returns a vector made of the elements XZYY of this one, like
+// the GLSL swizzle v.xzyy.
+func (v Vec3) XZYY() Vec4 {
+	return Vec4{v[0], v[2], v[1], v[1]}
+}
+
+// XZYZ returns a vector made of the elements XZYZ of this one, like
+// the GLSL swizzle v.xzyz.
+func (v Vec3) XZYZ() Vec4 {
+	return Vec4{v[0], v[2], v[1], v[2]}
+}
+
+// XZZX returns a vector made of the elements XZZX of this one, like
+// the GLSL swizzle v.xzzx.
+func (v Vec3) XZZX() Vec4 {
+	return Vec4{v[0], v[2], v[2], v[0]}
+}
+
+// XZZY returns a vector made of the elements XZZY of this one, like
+// the GLSL swizzle v.xzzy.
+func (v Vec3) XZZY() Vec4 {
+	return Vec4{v[0], v[2], v[2], v[1]}
+}
+
+// XZZZ returns a vector made of the elements XZZZ of this one, like
+// the GLSL swizzle v.xzzz.
+func (v Vec3) XZZZ() Vec4 {
+	return Vec4{v[0], v[2], v[2], v[2]}
+}
+
+// YXXX returns a vector made of the elements YXXX of this one, like
+// the GLSL swizzle v.yxxx.
+func (v Vec3) YXXX() Vec4 {
+	return Vec4{v[1], v[0], v[0], v[0]}
+}
+
+// YXXY returns a vector made of the elements YXXY of this one, like
+// the GLSL swizzle v.yxxy.
+func (v Vec3) YXXY() Vec4 {
+	return Vec4{v[1], v[0], v[0], v[1]}
+}
+
+// YXXZ returns a vector made of the elements YXXZ of this one, like
+// the GLSL swizzle v.yxxz.
+func (v Vec3) YXXZ() Vec4 {
+	return Vec4{v[1], v[0], v[0], v[2]}
+}
+
+// YXYX returns a vector made of the elements YXYX of this one, like
+// the GLSL swizzle v.yxyx.
+func (v Vec3) YXYX() Vec4 {
+	return Vec4{v[1], v[0], v[1], v[0]}
+}
+
+// YXYY returns a vector made of the elements YXYY of this one, like
+// the GLSL swizzle v.yxyy.
+func (v Vec3) YXYY() Vec4 {
+	return Vec4{v[1], v[0], v[1], v[1]}
+}
+
+// YXYZ returns a vector made of the elements YXYZ of this one, like
+// the GLSL swizzle v.yxyz.
+func (v Vec3) YXYZ() Vec4 {
+	return Vec4{v[1], v[0], v[1], v[2]}
+}
+
+// YXZX returns a vector made of the elements YXZX of this one, like
+// the GLSL swizzle v.yxzx.
+func (v Vec3) YXZX() Vec4 {
+	return Vec4{v[1], v[0], v[2], v[0]}
+}
+
+// YXZY returns a vector made of the elements YXZY of this one, like
+// the GLSL swizzle v.yxzy.
+func (v Vec3) YXZY() Vec4 {
+	return Vec4{v[1], v[0], v[2], v[1]}
+}
+
+// YXZZ returns a vector made of the elements YXZZ of this one, like
+// the GLSL swizzle v.yxzz.
+func (v Vec3) YXZZ() Vec4 {
+	return Vec4{v[1], v[0], v[2], v[2]}
+}
+
+// YYXX returns a vector made of the elements YYXX of this one, like
+// the GLSL swizzle v.yyxx.
+func (v Vec3) YYXX() Vec4 {
+	return Vec4{v[1], v[1], v[0], v[0]}
+}
+
+// YYXY returns a vector made of the elements YYXY of this one, like
+// the GLSL swizzle v.yyxy.
+func (v Vec3) YYXY() Vec4 {
+	return Vec4{v[1], v[1], v[0], v[1]}
+}
+
+// YYXZ returns a vector made of the elements YYXZ of this one, like
+// the GLSL swizzle v.yyxz.
+func (v Vec3) YYXZ() Vec4 {
+	return Vec4{v[1], v[1], v[0], v[2]}
+}
+
+// YYYX returns a vector made of the elements YYYX of this one, like
+// the GLSL swizzle v.yyyx.
+func (v Vec3) YYYX() Vec4 {
+	return Vec4{v[1], v[1], v[1], v[0]}
+}
+
+// YYYY returns a vector made of the elements YYYY of this one, like
+// the GLSL swizzle v.yyyy.
+func (v Vec3) YYYY() Vec4 {
+	return Vec4{v[1], v[1], v[1], v[1]}
+}
+
+// YYYZ returns a vector made of the elements YYYZ of this one, like
+// the GLSL swizzle v.yyyz.
+func (v Vec3) YYYZ() Vec4 {
+	return Vec4{v[1], v[1], v[1], v[2]}
+}
+
+// YYZX returns a vector made of the elements YYZX of this one, like
+// the GLSL swizzle v.yyzx.
+func (v Vec3) YYZX() Vec4 {
+	return Vec4{v[1], v[1], v[2], v[0]}
+}
+
+// YYZY returns a vector made of the elements YYZY of this one, like
+// the GLSL swizzle v.yyzy.
+func (v Vec3) YYZY() Vec4 {
+	return Vec4{v[1], v[1], v[2], v[1]}
+}
+
+// YYZZ returns a vector made of the elements YYZZ of this one, like
+// the GLSL swizzle v.yyzz.
+func (v Vec3) YYZZ() Vec4 {
+	return Vec4{v[1], v[1], v[2], v[2]}
+}
+
+// YZXX returns a vector made of the elements YZXX of this one, like
+// the GLSL swizzle v.yzxx.
+func (v Vec3) YZXX() Vec4 {
+	return Vec4{v[1], v[2], v[0], v[0]}
+}
+
+// YZXY returns a vector made of the elements YZXY of this one, like
+// the GLSL swizzle v.yzxy.
+func (v Vec3) YZXY() Vec4 {
+	return Vec4{v[1], v[2], v[0], v[1]}
+}
+
+// YZXZ returns a vector made of the elements YZXZ of this one, like
+// the GLSL swizzle v.yzxz.
+func (v Vec3) YZXZ() Vec4 {
+	return Vec4{v[1], v[2], v[0], v[2]}
+}
+
+// YZYX returns a vector made of the elements YZYX of this one, like
+// the GLSL swizzle v.yzyx.
+func (v Vec3) YZYX() Vec4 {
+	return Vec4{v[1], v[2], v[1], v[0]}
+}
+
+// YZYY returns a vector made of the elements YZYY of this one, like
+// the GLSL swizzle v.yzyy.
+func (v Vec3) YZYY() Vec4 {
+	return Vec4{v[1], v[2], v[1], v[1]}
+}
+
+// YZYZ returns a vector made of the elements YZYZ of this one, like
+// the GLSL swizzle v.yzyz.
+func (v Vec3) YZYZ() Vec4 {
+	return Vec4{v[1], v[2], v[1], v[2]}
+}
+
+// YZZX returns a vector made of the elements YZZX of this one, like
+// the GLSL swizzle v.yzzx.
+func (v Vec3) YZZX() Vec4 {
+	return Vec4{v[1], v[2], v[2], v[0]}
+}
+
+// YZZY returns a vector made of the elements YZZY of this one, like
+// the GLSL swizzle v.yzzy.
+func (v Vec3) YZZY() Vec4 {
+	return Vec4{v[1], v[2], v[2], v[1]}
+}
+
+// YZZZ returns a vector made of the elements YZZZ of this one, like
+// the GLSL swizzle v.yzzz.
+func (v Vec3) YZZZ() Vec4 {
+	return Vec4{v[1], v[2], v[2], v[2]}
+}
+
+// ZXXX returns a vector made of the elements ZXXX of this one, like
+// the GLSL swizzle v.zxxx.
+func (v Vec3) ZXXX() Vec4 {
+	return Vec4{v[2], v[0], v[0], v[0]}
+}
+
+// ZXXY returns a vector made of the elements ZXXY of this one, like
+// the GLSL swizzle v.zxxy.
+func (v Vec3) ZXXY() Vec4 {
+	return Vec4{v[2], v[0], v[0], v[1]}
+}
+
+// ZXXZ returns a vector made of the elements ZXXZ of this one, like
+// the GLSL swizzle v.zxxz.
+func (v Vec3) ZXXZ() Vec4 {
+	return Vec4{v[2], v[0], v[0], v[2]}
+}
+
+// ZXYX returns a vector made of the elements ZXYX of this one, like
+// the GLSL swizzle v.zxyx.
+func (v Vec3) ZXYX() Vec4 {
+	return Vec4{v[2], v[0], v[1], v[0]}
+}
+
+// ZXYY returns a vector made of the elements ZXYY of this one, like
+// the GLSL swizzle v.zxyy.
+func (v Vec3) ZXYY() Vec4 {
+	return Vec4{v[2], v[0], v[1], v[1]}
+}
+
+// ZXYZ returns a vector made of the elements ZXYZ of this one, like
+// the GLSL swizzle v.zxyz.
+func (v Vec3) ZXYZ() Vec4 {
+	return Vec4{v[2], v[0], v[1], v[2]}
+}
+
+// ZXZX returns a vector made of the elements ZXZX of this one, like
+// the GLSL swizzle v.zxzx.
+func (v Vec3) ZXZX() Vec4 {
+	return Vec4{v[2], v[0], v[2], v[0]}
+}
+
+// ZXZY returns a vector made of the elements ZXZY of this one, like
+// the GLSL swizzle v.zxzy.
+func (v Vec3) ZXZY() Vec4 {
+	return Vec4{v[2], v[0], v[2], v[1]}
+}
+
+// ZXZZ returns a vector made of the elements ZXZZ of this one, like
+// the GLSL swizzle v.zxzz.
+func (v Vec3) ZXZZ() Vec4 {
+	return Vec4{v[2], v[0], v[2], v[2]}
+}
+
+// ZYXX returns a vector made of the elements ZYXX of this one, like
+// the GLSL swizzle v.zyxx.
+func (v Vec3) ZYXX() Vec4 {
+	return Vec4{v[2], v[1], v[0], v[0]}
+}
+
+// ZYXY returns a vector made of the elements ZYXY of this one, like
+// the GLSL swizzle v.zyxy.
+func (v Vec3) ZYXY() Vec4 {
+	return Vec4{v[2], v[1], v[0], v[1]}
+}
+
+// ZYXZ returns a vector made of the elements ZYXZ of this one, like
+// the GLSL swizzle v.zyxz.
+func (v Vec3) ZYXZ() Vec4 {
+	return Vec4{v[2], v[1], v[0], v[2]}
+}
+
+// ZYYX returns a vector made of the elements ZYYX of this one, like
+// the GLSL swizzle v.zyyx.
+func (v Vec3) ZYYX() Vec4 {
+	return Vec4{v[2], v[1], v[1], v[0]}
+}
+
+// ZYYY returns a vector made of the elements ZYYY of this one, like
+// the GLSL swizzle v.zyyy.
+func (v Vec3) ZYYY() Vec4 {
+	return Vec4{v[2], v[1], v[1], v[1]}
+}
+
+// ZYYZ returns a vector made of the elements ZYYZ of this one, like
+// the GLSL swizzle v.zyyz.
+func (v Vec3) ZYYZ() Vec4 {
+	return Vec4{v[2], v[1], v[1], v[2]}
+}
+
+// ZYZX returns a vector made of the elements ZYZX of this one, like
+// the GLSL swizzle v.zyzx.
+func (v Vec3) ZYZX() Vec4 {
+	return Vec4{v[2], v[1], v[2], v[0]}
+}
+
+// ZYZY returns a vector made of the elements ZYZY of this one, like
+// the GLSL swizzle v.zyzy.
+func (v Vec3) ZYZY() Vec4 {
+	return Vec4{v[2], v[1], v[2], v[1]}
+}
+
+// ZYZZ returns a vector made of the elements ZYZZ of this one, like
+// the GLSL swizzle v.zyzz.
+func (v Vec3) ZYZZ() Vec4 {
+	return Vec4{v[2], v[1], v[2], v[2]}
+}
+
+// ZZXX returns a vector made of the elements ZZXX of this one, like
+// the GLSL swizzle v.zzxx.
+func (v Vec3) ZZXX() Vec4 {
+	return Vec4{v[2], v[2], v[0], v[0]}
+}
+
+// ZZXY returns a vector made of the elements ZZXY of this one, like
+// the GLSL swizzle v.zzxy.
+func (v Vec3) ZZXY() Vec4 {
+	return Vec4{v[2], v[2], v[0], v[1]}
+}
+
+// ZZXZ returns a vector made of the elements ZZXZ of this one, like
+// the GLSL swizzle v.zzxz.
+func (v Vec3) ZZXZ() Vec4 {
+	return Vec4{v[2], v[2], v[0], v[2]}
+}
+
+// ZZYX returns a vector made of the elements ZZYX of this one, like
+// the GLSL swizzle v.zzyx.
+func (v Vec3) ZZYX() Vec4 {
+	return Vec4{v[2], v[2], v[1], v[0]}
+}
+
+// ZZYY returns a vector made of the elements ZZYY of this one, like
+// the GLSL swizzle v.zzyy.
+func (v Vec3) ZZYY() Vec4 {
+	return Vec4{v[2], v[2], v[1], v[1]}
+}
+
+// ZZYZ returns a vector made of the elements ZZYZ of this one, like
+// the GLSL swizzle v.zzyz.
+func (v Vec3) ZZYZ() Vec4 {
+	return Vec4{v[2], v[2], v[1], v[2]}
+}
+
+// ZZZX returns a vector made of the elements ZZZX of this one, like
+// the GLSL swizzle v.zzzx.
+func (v Vec3) ZZZX() Vec4 {
+	return Vec4{v[2], v[2], v[2], v[0]}
+}
+
+// ZZZY returns a vector made of the elements ZZZY of this one, like
+// the GLSL swizzle v.zzzy.
+func (v Vec3) ZZZY() Vec4 {
+	return Vec4{v[2], v[2], v[2], v[1]}
+}
+
+// ZZZZ returns a vector made of the elements ZZZZ of this one, like
+// the GLSL swizzle v.zzzz.
+func (v Vec3) ZZZZ() Vec4 {
+	return Vec4{v[2], v[2], v[2], v[2]}
+}
+
+// OuterProd2 does the vector outer product
+// of two vectors. The outer product produces an
+// 3x2 matrix. E.G. a Vec3 * Vec2 = Mat3x2.
+//
+// The outer product can be thought of as the "opposite"
+// of the Dot product. The Dot product treats both vectors like matrices
+// oriented such that the left one has N columns and the right has N rows.
+// So Vec3.Vec3 = Mat1x3*Mat3x1 = Mat1 = Scalar.
+//
+// The outer product orients it so they're facing "outward": Vec2*Vec3
+// = Mat2x1*Mat1x3 = Mat2x3.
+func (v1 Vec3) OuterProd2(v2 Vec2) Mat3x2 {
+	return Mat3x2{v1[0] * v2[0], v1[1] * v2[0], v1[2] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[2] * v2[1]}
+}
+
+// OuterProd3 does the vector outer product
+// of two vectors. The outer product produces an
+// 3x3 matrix. E.G. a Vec3 * Vec3 = Mat3.
+//
+// The outer product can be thought of as the "opposite"
+// of the Dot product. The Dot product treats both vectors like matrices
+// oriented such that the left one has N columns and the right has N rows.
+// So Vec3.Vec3 = Mat1x3*Mat3x1 = Mat1 = Scalar.
+//
+// The outer product orients it so they're facing "outward": Vec2*Vec3
+// = Mat2x1*Mat1x3 = Mat2x3.
+func (v1 Vec3) OuterProd3(v2 Vec3) Mat3 {
+	return Mat3{v1[0] * v2[0], v1[1] * v2[0], v1[2] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[2] * v2[1], v1[0] * v2[2], v1[1] * v2[2], v1[2] * v2[2]}
+}
+
+// OuterProd4 does the vector outer product
+// of two vectors. The outer product produces an
+// 3x4 matrix. E.G. a Vec3 * Vec4 = Mat3x4.
+//
+// The outer product can be thought of as the "opposite"
+// of the Dot product. The Dot product treats both vectors like matrices
+// oriented such that the left one has N columns and the right has N rows.
+// So Vec3.Vec3 = Mat1x3*Mat3x1 = Mat1 = Scalar.
+//
+// The outer product orients it so they're facing "outward": Vec2*Vec3
+// = Mat2x1*Mat1x3 = Mat2x3.
+func (v1 Vec3) OuterProd4(v2 Vec4) Mat3x4 {
+	return Mat3x4{v1[0] * v2[0], v1[1] * v2[0], v1[2] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[2] * v2[1], v1[0] * v2[2], v1[1] * v2[2], v1[2] * v2[2], v1[0] * v2[3], v1[1] * v2[3], v1[2] * v2[3]}
+}
+
+// Vec4i converts the vector to an integer vector, rounding every element
+// as specified by mode. Elements out of the range of int, and NaNs, give an
+// undefined result.
+func (v Vec4) Vec4i(mode RoundingMode) Vec4i {
+	return Vec4i{roundToInt(v[0], mode), roundToInt(v[1], mode), roundToInt(v[2], mode), roundToInt(v[3], mode)}
+}
+
+// Add performs element-wise addition between two vectors. It is equivalent to iterating
+// over every element of v1 and adding the corresponding element of v2 to it.
+func (v1 Vec4) Add(v2 Vec4) Vec4 {
+	return Vec4{v1[0] + v2[0], v1[1] + v2[1], v1[2] + v2[2], v1[3] + v2[3]}
+}
+
+// Sub performs element-wise subtraction between two vectors. It is equivalent to iterating
+// over every element of v1 and subtracting the corresponding element of v2 from it.
+func (v1 Vec4) Sub(v2 Vec4) Vec4 {
+	return Vec4{v1[0] - v2[0], v1[1] - v2[1], v1[2] - v2[2], v1[3] - v2[3]}
+}
+
+// Mul performs a scalar multiplication between the vector and some constant value
+// c. This is equivalent to iterating over every vector element and multiplying by c.
+func (v1 Vec4) Mul(c float64) Vec4 {
+	return Vec4{v1[0] * c, v1[1] * c, v1[2] * c, v1[3] * c}
+}
+
+// Dot returns the dot product of this vector with another. There are multiple ways
+// to describe this value. One is the multiplication of their lengths and cos(theta) where
+// theta is the angle between the vectors: v1.v2 = |v1||v2|cos(theta).
+//
+// The other (and what is actually done) is the sum of the element-wise multiplication of all
+// elements. So for instance, two Vec3s would yield v1.x * v2.x + v1.y * v2.y + v1.z * v2.z.
+//
+// This means that the dot product of a vector and itself is the square of its Len (within
+// the bounds of floating points error).
+//
+// The dot product is roughly a measure of how closely two vectors are to pointing in the same
+// direction. If both vectors are normalized, the value will be -1 for opposite pointing,
+// one for same pointing, and 0 for perpendicular vectors.
+func (v1 Vec4) Dot(v2 Vec4) float64 {
+	return v1[0]*v2[0] + v1[1]*v2[1] + v1[2]*v2[2] + v1[3]*v2[3]
+}
+
+// Len returns the vector's length. Note that this is NOT the dimension of
+// the vector (len(v)), but the mathematical length. This is equivalent to the square
+// root of the sum of the squares of all elements. E.G. for a Vec2 it's
+// math.Hypot(v[0], v[1]).
+func (v1 Vec4) Len() float64 {
+
+	return float64(math.Sqrt(float64(v1[0]*v1[0] + v1[1]*v1[1] + v1[2]*v1[2] + v1[3]*v1[3])))
+
+}
+
+// LenSqr returns the vector's square length. This is equivalent to the sum of the squares of all elements.
+func (v1 Vec4) LenSqr() float64 {
+	return v1[0]*v1[0] + v1[1]*v1[1] + v1[2]*v1[2] + v1[3]*v1[3]
+}
+
+// Normalize normalizes the vector. Normalization is (1/|v|)*v,
+// making this equivalent to v.Scale(1/v.Len()). If the len is 0.0,
+// this function will return an infinite value for all elements due
+// to how floating point division works in Go (n/0.0 = math.Inf(Sign(n))).
+//
+// Normalization makes a vector's Len become 1.0 (within the margin of floating point error),
+// while maintaining its directionality.
+//
+// (Can be seen here: http://play.golang.org/p/Aaj7SnbqIp )
+func (v1 Vec4) Normalize() Vec4 {
+	l := 1.0 / v1.Len()
+	return Vec4{v1[0] * l, v1[1] * l, v1[2] * l, v1[3] * l}
+}
+
+// ApproxEqual takes in a vector and does an element-wise approximate float
+// comparison as if FloatEqual had been used
+func (v1 Vec4) ApproxEqual(v2 Vec4) bool {
+	for i := range v1 {
+		if !FloatEqual(v1[i], v2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApproxEqualThreshold takes in a threshold for comparing two floats, and uses
+// it to do an element-wise comparison of the vector to another.
+func (v1 Vec4) ApproxEqualThreshold(v2 Vec4, threshold float64) bool {
+	for i := range v1 {
+		if !FloatEqualThreshold(v1[i], v2[i], threshold) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApproxFuncEqual takes in a func that compares two floats, and uses it to do an element-wise
+// comparison of the vector to another. This is intended to be used with FloatEqualFunc
+func (v1 Vec4) ApproxFuncEqual(v2 Vec4, eq func(float64, float64) bool) bool {
+	for i := range v1 {
+		if !eq(v1[i], v2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MulElem performs element-wise multiplication between two vectors, like the
+// * operator on GLSL vectors.
+func (v1 Vec4) MulElem(v2 Vec4) Vec4 {
+	return Vec4{v1[0] * v2[0], v1[1] * v2[1], v1[2] * v2[2], v1[3] * v2[3]}
+}
+
+// DivElem performs element-wise division between two vectors, like the /
+// operator on GLSL vectors.
+func (v1 Vec4) DivElem(v2 Vec4) Vec4 {
+	return Vec4{v1[0] / v2[0], v1[1] / v2[1], v1[2] / v2[2], v1[3] / v2[3]}
+}
+
+// Min returns the element-wise minimum of the two vectors.
+func (v1 Vec4) Min(v2 Vec4) Vec4 {
+	for i := range v1 {
+		SetMin(&v1[i], &v2[i])
+	}
+	return v1
+}
+
+// Max returns the element-wise maximum of the two vectors.
+func (v1 Vec4) Max(v2 Vec4) Vec4 {
+	for i := range v1 {
+		SetMax(&v1[i], &v2[i])
+	}
+	return v1
+}
+
+// Abs returns the vector with the absolute value of every element.
+func (v1 Vec4) Abs() Vec4 {
+	return Vec4{Abs(v1[0]), Abs(v1[1]), Abs(v1[2]), Abs(v1[3])}
+}
+
+// Floor returns the vector with every element rounded down.
+func (v1 Vec4) Floor() Vec4 {
+	return Vec4{float64(math.Floor(float64(v1[0]))), float64(math.Floor(float64(v1[1]))), float64(math.Floor(float64(v1[2]))), float64(math.Floor(float64(v1[3])))}
+}
+
+// Ceil returns the vector with every element rounded up.
+func (v1 Vec4) Ceil() Vec4 {
+	return Vec4{float64(math.Ceil(float64(v1[0]))), float64(math.Ceil(float64(v1[1]))), float64(math.Ceil(float64(v1[2]))), float64(math.Ceil(float64(v1[3])))}
+}
+
+// Clamp clamps every element of the vector to the range given by the matching
+// elements of low and high, as with Clamp.
+func (v1 Vec4) Clamp(low, high Vec4) Vec4 {
+	return Vec4{Clamp(v1[0], low[0], high[0]), Clamp(v1[1], low[1], high[1]), Clamp(v1[2], low[2], high[2]), Clamp(v1[3], low[3], high[3])}
+}
+
+// Lerp linearly interpolates between v1 and v2, returning v1 for t = 0 and v2
+// for t = 1. This is GLSL's mix.
+func (v1 Vec4) Lerp(v2 Vec4, t float64) Vec4 {
+	return Vec4{v1[0] + (v2[0]-v1[0])*t, v1[1] + (v2[1]-v1[1])*t, v1[2] + (v2[2]-v1[2])*t, v1[3] + (v2[3]-v1[3])*t}
+}
+
+// Step returns, for every element, 0 if it is less than the matching element
+// of edge, and 1 otherwise, like GLSL's step(edge, v1).
+func (v1 Vec4) Step(edge Vec4) Vec4 {
+	var res Vec4
+	for i := range v1 {
+		if v1[i] >= edge[i] {
+			res[i] = 1
+		}
+	}
+	return res
+}
+
+// SmoothStep performs, for every element, smooth Hermite interpolation
+// between 0 and 1 as it goes from the matching element of edge0 to that of
+// edge1, like GLSL's smoothstep(edge0, edge1, v1). The result is undefined if
+// an element of edge0 is not less than that of edge1.
+func (v1 Vec4) SmoothStep(edge0, edge1 Vec4) Vec4 {
+	var res Vec4
+	for i := range v1 {
+		t := Clamp((v1[i]-edge0[i])/(edge1[i]-edge0[i]), 0, 1)
+		res[i] = t * t * (3 - 2*t)
+	}
+	return res
+}
+
+// Reflect returns the direction of the incident vector v1 reflected off a
+// surface with the normal n, v1 - 2*Dot(n, v1)*n. n should be normalized.
+func (v1 Vec4) Reflect(n Vec4) Vec4 {
+	return v1.Sub(n.Mul(2 * n.Dot(v1)))
+}
+
+// Refract returns the direction of the incident vector v1 refracted at a
+// surface with the normal n, where eta is the ratio of the indices of
+// refraction (that of the medium v1 comes from over that of the medium it
+// enters). Like GLSL's refract, v1 and n should be normalized, and on total
+// internal reflection this returns the zero vector.
+func (v1 Vec4) Refract(n Vec4, eta float64) Vec4 {
+	d := n.Dot(v1)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vec4{}
+	}
+	return v1.Mul(eta).Sub(n.Mul(eta*d + float64(math.Sqrt(float64(k)))))
+}
+
+// X is an element access func, it is equivalent to v[n] where
+// n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
+// show that this is more or less as fast as direct acces, probably due to
+// inlining, so use v[0] or v.X() depending on personal preference.
+func (v Vec4) X() float64 {
+	return v[0]
+}
+
+// Y is an element access func, it is equivalent to v[n] where
+// n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
+// show that this is more or less as fast as direct acces, probably due to
+// inlining, so use v[0] or v.X() depending on personal preference.
+func (v Vec4) Y() float64 {
+	return v[1]
+}
+
+// Z is an element access func, it is equivalent to v[n] where
+// n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
+// show that this is more or less as fast as direct acces, probably due to
+// inlining, so use v[0] or v.X() depending on personal preference.
+func (v Vec4) Z() float64 {
+	return v[2]
+}
+
+// W is an element access func, it is equivalent to v[n] where
+// n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
+// show that this is more or less as fast as direct acces, probably due to
+// inlining, so use v[0] or v.X() depending on personal preference.
+func (v Vec4) W() float64 {
+	return v[3]
+}
+
+// XX returns a vector made of the elements XX of this one, like
+// the GLSL swizzle v.xx.
+func (v Vec4) XX() Vec2 {
+	return Vec2{v[0], v[0]}
+}
+
+// XY returns a vector made of the elements XY of this one, like
+// the GLSL swizzle v.xy.
+func (v Vec4) XY() Vec2 {
+	return Vec2{v[0], v[1]}
+}
+
+// XZ returns a vector made of the elements XZ of this one, like
+// the GLSL swizzle v.xz.
+func (v Vec4) XZ() Vec2 {
+	return Vec2{v[0], v[2]}
+}
+
+// XW returns a vector made of the elements XW of this one, like
+// the GLSL swizzle v.xw.
+func (v Vec4) XW() Vec2 {
+	return Vec2{v[0], v[3]}
+}
+
+// YX returns a vector made of the elements YX of this one, like
+// the GLSL swizzle v.yx.
+func (v Vec4) YX() Vec2 {
+	return Vec2{v[1], v[0]}
+}
+
+// YY returns a vector made of the elements YY of this one, like
+// the GLSL swizzle v.yy.
+func (v Vec4) YY() Vec2 {
+	return Vec2{v[1], v[1]}
+}
+
+// YZ returns a vector made of the elements YZ of this one, like
+// the GLSL swizzle v.yz.
+func (v Vec4) YZ() Vec2 {
+	return Vec2{v[1], v[2]}
+}
+
+// YW returns a vector made of the elements YW of this one, like
+// the GLSL swizzle v.yw.
+func (v Vec4) YW() Vec2 {
+	return Vec2{v[1], v[3]}
+}
+
+// ZX returns a vector made of the elements ZX of this one, like
+// the GLSL swizzle v.zx.
+func (v Vec4) ZX() Vec2 {
+	return Vec2{v[2], v[0]}
+}
+
+// ZY returns a vector made of the elements ZY of this one, like
+// the GLSL swizzle v.zy.
+func (v Vec4) ZY() Vec2 {
+	return Vec2{v[2], v[1]}
+}
+
+// ZZ returns a vector made of the elements ZZ of this one, like
+// the GLSL swizzle v.zz.
+func (v Vec4) ZZ() Vec2 {
+	return Vec2{v[2], v[2]}
+}
+
+// ZW returns a vector made of the elements ZW of this one, like
+// the GLSL swizzle v.zw.
+func (v Vec4) ZW() Vec2 {
+	return Vec2{v[2], v[3]}
+}
+
+// WX returns a vector made of the elements WX of this one, like
+// the GLSL swizzle v.wx.
+func (v Vec4) WX() Vec2 {
+	return Vec2{v[3], v[0]}
+}
+
+// WY returns a vector made of the elements WY of this one, like
+// the GLSL swizzle v.wy.
+func (v Vec4) WY() Vec2 {
+	return Vec2{v[3], v[1]}
+}
+
+// WZ returns a vector made of the elements WZ of this one, like
+// the GLSL swizzle v.wz.
+func (v Vec4) WZ() Vec2 {
+	return Vec2{v[3], v[2]}
+}
+
+// WW returns a vector made of the elements WW of this one, like
+// the GLSL swizzle v.ww.
+func (v Vec4) WW() Vec2 {
+	return Vec2{v[3], v[3]}
+}
+
+// XXX returns a vector made of the elements XXX of this one, like
+// the GLSL swizzle v.xxx.
+func (v Vec4) XXX() Vec3 {
+	return Vec3{v[0], v[0], v[0]}
+}
+
+// XXY returns a vector made of the elements XXY of this one, like
+// the GLSL swizzle v.xxy.
+func (v Vec4) XXY() Vec3 {
+	return Vec3{v[0], v[0], v[1]}
+}
+
+// XXZ returns a vector made of the elements XXZ of this one, like
+// the GLSL swizzle v.xxz.
+func (v Vec4) XXZ() Vec3 {
+	return Vec3{v[0], v[0], v[2]}
+}
+
+// XXW returns a vector made of the elements XXW of this one, like
+// the GLSL swizzle v.xxw.
+func (v Vec4) XXW() Vec3 {
+	return Vec3{v[0], v[0], v[3]}
+}
+
+// XYX returns a vector made of the elements XYX of this one, like
+// the GLSL swizzle v.xyx.
+func (v Vec4) XYX() Vec3 {
+	return Vec3{v[0], v[1], v[0]}
+}
+
+// XYY returns a vector made of the elements XYY of this one, like
+// the GLSL swizzle v.xyy.
+func (v Vec4) XYY() Vec3 {
+	return Vec3{v[0], v[1], v[1]}
+}
+
+// XYZ returns a vector made of the elements XYZ of this one, like
+// the GLSL swizzle v.xyz.
+func (v Vec4) XYZ() Vec3 {
+	return Vec3{v[0], v[1], v[2]}
+}
+
+// XYW returns a vector made of the elements XYW of this one, like
+// the GLSL swizzle v.xyw.
+func (v Vec4) XYW() Vec3 {
+	return Vec3{v[0], v[1], v[3]}
+}
+
+// XZX returns a vector made of the elements XZX of this one, like
+// the GLSL swizzle v.xzx.
+func (v Vec4) XZX() Vec3 {
+	return Vec3{v[0], v[2], v[0]}
+}
+
+// XZY returns a vector made of the elements XZY of this one, like
+// the GLSL swizzle v.xzy.
+func (v Vec4) XZY() Vec3 {
+	return Vec3{v[0], v[2], v[1]}
+}
+
+// XZZ returns a vector made of the elements XZZ of this one, like
+// the GLSL swizzle v.xzz.
+func (v Vec4) XZZ() Vec3 {
+	return Vec3{v[0], v[2], v[2]}
+}
+
+// XZW returns a vector made of the elements XZW of this one, like
+// the GLSL swizzle v.xzw.
+func (v Vec4) XZW() Vec3 {
+	return Vec3{v[0], v[2], v[3]}
+}
+
+// XWX returns a vector made of the elements XWX of this one, like
+// the GLSL swizzle v.xwx.
+func (v Vec4) XWX() Vec3 {
+	return Vec3{v[0], v[3], v[0]}
+}
+
+// XWY returns a vector made of the elements XWY of this one, like
+// the GLSL swizzle v.xwy.
+func (v Vec4) XWY() Vec3 {
+	return Vec3{v[0], v[3], v[1]}
+}
+
+// XWZ returns a vector made of the elements XWZ of this one, like
+// the GLSL swizzle v.xwz.
+func (v Vec4) XWZ() Vec3 {
+	return Vec3{v[0], v[3], v[2]}
+}
+
+// XWW returns a vector made of the elements XWW of this one, like
+// the GLSL swizzle v.xww.
+func (v Vec4) XWW() Vec3 {
+	return Vec3{v[0], v[3], v[3]}
+}
+
+// YXX returns a vector made of the elements YXX of this one, like
+// the GLSL swizzle v.yxx.
+func (v Vec4) YXX() Vec3 {
+	return Vec3{v[1], v[0], v[0]}
+}
+
+// YXY returns a vector made of the elements YXY of this one, like
+// the GLSL swizzle v.yxy.
+func (v Vec4) YXY() Vec3 {
+	return Vec3{v[1], v[0], v[1]}
+}
+
+// YXZ returns a vector made of the elements YXZ of this one, like
+// the GLSL swizzle v.yxz.
+func (v Vec4) YXZ() Vec3 {
+	return Vec3{v[1], v[0], v[2]}
+}
+
+// YXW returns a vector made of the elements YXW of this one, like
+// the GLSL swizzle v.yxw.
+func (v Vec4) YXW() Vec3 {
+	return Vec3{v[1], v[0], v[3]}
+}
+
+// YYX returns a vector made of the elements YYX of this one, like
+// the GLSL swizzle v.yyx.
+func (v Vec4) YYX() Vec3 {
+	return Vec3{v[1], v[1], v[0]}
+}
+
+// YYY returns a vector made of the elements YYY of this one, like
+// the GLSL swizzle v.yyy.
+func (v Vec4) YYY() Vec3 {
+	return Vec3{v[1], v[1], v[1]}
+}
+
+// YYZ returns a vector made of the elements YYZ of this one, like
+// the GLSL swizzle v.yyz.
+func (v Vec4) YYZ() Vec3 {
+	return Vec3{v[1], v[1], v[2]}
+}
+
+// YYW returns a vector made of the elements YYW of this one, like
+// the GLSL swizzle v.yyw.
+func (v Vec4) YYW() Vec3 {
+	return Vec3{v[1], v[1], v[3]}
+}
+
+// YZX returns a vector made of the elements YZX of this one, like
+// the GLSL swizzle v.yzx.
+func (v Vec4) YZX() Vec3 {
+	return Vec3{v[1], v[2], v[0]}
+}
+
+// YZY returns a vector made of the elements YZY of this one, like
+// the GLSL swizzle v.yzy.
+func (v Vec4) YZY() Vec3 {
+	return Vec3{v[1], v[2], v[1]}
+}
+
+// YZZ returns a vector made of the elements YZZ of this one, like
+// the GLSL swizzle v.yzz.
+func (v Vec4) YZZ() Vec3 {
+	return Vec3{v[1], v[2], v[2]}
+}
+
+// YZW returns a vector made of the elements YZW of this one, like
+// the GLSL swizzle v.yzw.
+func (v Vec4) YZW() Vec3 {
+	return Vec3{v[1], v[2], v[3]}
+}
+
+// YWX returns a vector made of the elements YWX of this one, like
+// the GLSL swizzle v.ywx.
+func (v Vec4) YWX() Vec3 {
+	return Vec3{v[1], v[3], v[0]}
+}
+
+// YWY returns a vector made of the elements YWY of this one, like
+// the GLSL swizzle v.ywy.
+func (v Vec4) YWY() Vec3 {
+	return Vec3{v[1], v[3], v[1]}
+}
+
+// YWZ returns a vector made of the elements YWZ of this one, like
+// the GLSL swizzle v.ywz.
+func (v Vec4) YWZ() Vec3 {
+	return Vec3{v[1], v[3], v[2]}
+}
+
+// YWW returns a vector made of the elements YWW of this one, like
+// the GLSL swizzle v.yww.
+func (v Vec4) YWW() Vec3 {
+	return Vec3{v[1], v[3], v[3]}
+}
+
+// ZXX returns a vector made of the elements ZXX of this one, like
+// the GLSL swizzle v.zxx.
+func (v Vec4) ZXX() Vec3 {
+	return Vec3{v[2], v[0], v[0]}
+}
+
+// ZXY returns a vector made of the elements ZXY of this one, like
+// the GLSL swizzle v.zxy.
+func (v Vec4) ZXY() Vec3 {
+	return Vec3{v[2], v[0], v[1]}
+}
+
+// ZXZ returns a vector made of the elements ZXZ of this one, like
+// the GLSL swizzle v.zxz.
+func (v Vec4) ZXZ() Vec3 {
+	return Vec3{v[2], v[0], v[2]}
+}
+
+// ZXW returns a vector made of the elements ZXW of this one, like
+// the GLSL swizzle v.zxw.
+func (v Vec4) ZXW() Vec3 {
+	return Vec3{v[2], v[0], v[3]}
+}
+
+// ZYX returns a vector made of the elements ZYX of this one, like
+// the GLSL swizzle v.zyx.
+func (v Vec4) ZYX() Vec3 {
+	return Vec3{v[2], v[1], v[0]}
+}
+
+// ZYY returns a vector made of the elements ZYY of this one, like
+// the GLSL swizzle v.zyy.
+func (v Vec4) ZYY() Vec3 {
+	return Vec3{v[2], v[1], v[1]}
+}
+
+// ZYZ returns a vector made of the elements ZYZ of this one, like
+// the GLSL swizzle v.zyz.
+func (v Vec4) ZYZ() Vec3 {
+	return Vec3{v[2], v[1], v[2]}
+}
+
+// ZYW returns a vector made of the elements ZYW of this one, like
+// the GLSL swizzle v.zyw.
+func (v Vec4) ZYW() Vec3 {
+	return Vec3{v[2], v[1], v[3]}
+}
+
+// ZZX returns a vector made of the elements ZZX of this one, like
+// the GLSL swizzle v.zzx.
+func (v Vec4) ZZX() Vec3 {
+	return Vec3{v[2], v[2], v[0]}
+}
+
+// ZZY returns a vector made of the elements ZZY of this one, like
+// the GLSL swizzle v.zzy.
+func (v Vec4) ZZY() Vec3 {
+	return Vec3{v[2], v[2], v[1]}
+}
+
+// ZZZ returns a vector made of the elements ZZZ of this one, like
+// the GLSL swizzle v.zzz.
+func (v Vec4) ZZZ() Vec3 {
+	return Vec3{v[2], v[2], v[2]}
+}
+
+// ZZW returns a vector made of the elements ZZW of this one, like
+// the GLSL swizzle v.zzw.
+func (v Vec4) ZZW() Vec3 {
+	return Vec3{v[2], v[2], v[3]}
+}
+
+// ZWX returns a vector made of the elements ZWX of this one, like
+// the GLSL swizzle v.zwx.
+func (v Vec4) ZWX() Vec3 {
+	return Vec3{v[2], v[3], v[0]}
+}
+
+// ZWY returns a vector made of the elements ZWY of this one, like
+// the GLSL swizzle v.zwy.
+func (v Vec4) ZWY() Vec3 {
+	return Vec3{v[2], v[3], v[1]}
+}
+
+// ZWZ returns a vector made of the elements ZWZ of this one, like
+// the GLSL swizzle v.zwz.
+func (v Vec4) ZWZ() Vec3 {
+	return Vec3{v[2], v[3], v[2]}
+}
+
+// ZWW returns a vector made of the elements ZWW of this one, like
+// the GLSL swizzle v.zww.
+func (v Vec4) ZWW() Vec3 {
+	return Vec3{v[2], v[3], v[3]}
+}
+
+// WXX returns a vector made of the elements WXX of this one, like
+// the GLSL swizzle v.wxx.
+func (v Vec4) WXX() Vec3 {
+	return Vec3{v[3], v[0], v[0]}
+}
+
+// WXY returns a vector made of the elements WXY of this one, like
+// the GLSL swizzle v.wxy.
+func (v Vec4) WXY() Vec3 {
+	return Vec3{v[3], v[0], v[1]}
+}
+
+// WXZ returns a vector made of the elements WXZ of this one, like
+// the GLSL swizzle v.wxz.
+func (v Vec4) WXZ() Vec3 {
+	return Vec3{v[3], v[0], v[2]}
+}
+
+// WXW returns a vector made of the elements WXW of this one, like
+// the GLSL swizzle v.wxw.
+func (v Vec4) WXW() Vec3 {
+	return Vec3{v[3], v[0], v[3]}
+}
+
+// WYX returns a vector made of the elements WYX of this one, like
+// the GLSL swizzle v.wyx.
+func (v Vec4) WYX() Vec3 {
+	return Vec3{v[3], v[1], v[0]}
+}
+
+// WYY returns a vector made of the elements WYY of this one, like
+// the GLSL swizzle v.wyy.
+func (v Vec4) WYY() Vec3 {
+	return Vec3{v[3], v[1], v[1]}
+}
+
+// WYZ returns a vector made of the elements WYZ of this one, like
+// the GLSL swizzle v.wyz.
+func (v Vec4) WYZ() Vec3 {
+	return Vec3{v[3], v[1], v[2]}
+}
+
+// WYW returns a vector made of the elements WYW of this one, like
+// the GLSL swizzle v.wyw.
+func (v Vec4) WYW() Vec3 {
+	return Vec3{v[3], v[1], v[3]}
+}
+
+// WZX returns a vector made of the elements WZX of this one, like
+// the GLSL swizzle v.wzx.
+func (v Vec4) WZX() Vec3 {
+	return Vec3{v[3], v[2], v[0]}
+}
+
+// WZY returns a vector made of the elements WZY of this one, like
+// the GLSL swizzle v.wzy.
+func (v Vec4) WZY() Vec3 {
+	return Vec3{v[3], v[2], v[1]}
+}
+
+// WZZ returns a vector made of the elements WZZ of this one, like
+// the GLSL swizzle v.wzz.
+func (v Vec4) WZZ() Vec3 {
+	return Vec3{v[3], v[2], v[2]}
+}
+
+// WZW returns a vector made of the elements WZW of this one, like
+// the GLSL swizzle v.wzw.
+func (v Vec4) WZW() Vec3 {
+	return Vec3{v[3], v[2], v[3]}
+}
+
+// WWX returns a vector made of the elements WWX of this one, like
+// the GLSL swizzle v.wwx.
+func (v Vec4) WWX() Vec3 {
+	return Vec3{v[3], v[3], v[0]}
+}
+
+// WWY returns a vector made of the elements WWY of this one, like
+// the GLSL swizzle v.wwy.
+func (v Vec4) WWY() Vec3 {
+	return Vec3{v[3], v[3], v[1]}
+}
+
+// WWZ returns a vector made of the elements WWZ of this one, like
+// the GLSL swizzle v.wwz.
+func (v Vec4) WWZ() Vec3 {
+	return Vec3{v[3], v[3], v[2]}
+}
+
+// WWW returns a vector made of the elements WWW of this one, like
+// the GLSL swizzle v.www.
+func (v Vec4) WWW() Vec3 {
+	return Vec3{v[3], v[3], v[3]}
+}
+
+// XXXX returns a vector made of the elements XXXX of this one, like
+// the GLSL swizzle v.xxxx.
+func (v Vec4) XXXX() Vec4 {
+	return Vec4{v[0], v[0], v[0], v[0]}
+}
+
+// XXXY returns a vector made of the elements XXXY of this one, like
+// the GLSL swizzle v.xxxy.
+func (v Vec4) XXXY() Vec4 {
+	return Vec4{v[0], v[0], v[0], v[1]}
+}
+
+// XXXZ returns a vector made of the elements XXXZ of this one, like
+// the GLSL swizzle v.xxxz.
+func (v Vec4) XXXZ() Vec4 {
+	return Vec4{v[0], v[0], v[0], v[2]}
+}
+
+// XXXW returns a vector made of the elements XXXW of this one, like
+// the GLSL swizzle v.xxxw.
+func (v Vec4) XXXW() Vec4 {
+	return Vec4{v[0], v[0], v[0], v[3]}
+}
+
+// XXYX returns a vector made of the elements XXYX of this one, like
+// the GLSL swizzle v.xxyx.
+func (v Vec4) XXYX() Vec4 {
+	return Vec4{v[0], v[0], v[1], v[0]}
+}
+
+// XXYY returns a vector made of the elements XXYY of this one, like
+// the GLSL swizzle v.xxyy.
+func (v Vec4) XXYY() Vec4 {
+	return Vec4{v[0], v[0], v[1], v[1]}
+}
+
+// XXYZ returns a vector made of the elements XXYZ of this one, like
+// the GLSL swizzle v.xxyz.
+func (v Vec4) XXYZ() Vec4 {
+	return Vec4{v[0], v[0], v[1], v[2]}
+}
+
+// XXYW returns a vector made of the elements XXYW of this one, like
+// the GLSL swizzle v.xxyw.
+func (v Vec4) XXYW() Vec4 {
+	return Vec4{v[0], v[0], v[1], v[3]}
+}
+
+// XXZX returns a vector made of the elements XXZX of this one, like
+// the GLSL swizzle v.xxzx.
+func (v Vec4) XXZX() Vec4 {
+	return Vec4{v[0], v[0], v[2], v[0]}
+}
+
+// XXZY returns a vector made of the elements XXZY of this one, like
+// the GLSL swizzle v.xxzy.
+func (v Vec4) XXZY() Vec4 {
+	return Vec4{v[0], v[0], v[2], v[1]}
+}
+
+// XXZZ returns a vector made of the elements XXZZ of this one, like
+// the GLSL swizzle v.xxzz.
+func (v Vec4) XXZZ() Vec4 {
+	return Vec4{v[0], v[0], v[2], v[2]}
+}
+
+// XXZW returns a vector made of the elements XXZW of this one, like
+// the GLSL swizzle v.xxzw.
+func (v Vec4) XXZW() Vec4 {
+	return Vec4{v[0], v[0], v[2], v[3]}
+}
+
+// XXWX returns a vector made of the elements XXWX of this one, like
+// the GLSL swizzle v.xxwx.
+func (v Vec4) XXWX() Vec4 {
+	return Vec4{v[0], v[0], v[3], v[0]}
+}
+
+// XXWY returns a vector made of the elements XXWY of this one, like
+// the GLSL swizzle v.xxwy.
+func (v Vec4) XXWY() Vec4 {
+	return Vec4{v[0], v[0], v[3], v[1]}
+}
+
+// XXWZ returns a vector made of the elements XXWZ of this one, like
+// the GLSL swizzle v.xxwz.
+func (v Vec4) XXWZ() Vec4 {
+	return Vec4{v[0], v[0], v[3], v[2]}
+}
+
+// XXWW returns a vector made of the elements XXWW of this one, like
+// the GLSL swizzle v.xxww.
+func (v Vec4) XXWW() Vec4 {
+	return Vec4{v[0], v[0], v[3], v[3]}
+}
+
+// XYXX returns a vector made of the elements XYXX of this one, like
+// the GLSL swizzle v.xyxx.
+func (v Vec4) XYXX() Vec4 {
+	return Vec4{v[0], v[1], v[0], v[0]}
+}
+
+// XYXY returns a vector made of the elements XYXY of this one, like
+// the GLSL swizzle v.xyxy.
+func (v Vec4) XYXY() Vec4 {
+	return Vec4{v[0], v[1], v[0], v[1]}
+}
+
+// XYXZ returns a vector made of the elements XYXZ of this one, like
+// the GLSL swizzle v.xyxz.
+func (v Vec4) XYXZ() Vec4 {
+	return Vec4{v[0], v[1], v[0], v[2]}
+}
+
+// XYXW returns a vector made of the elements XYXW of this one, like
+// the GLSL swizzle v.xyxw.
+func (v Vec4) XYXW() Vec4 {
+	return Vec4{v[0], v[1], v[0], v[3]}
+}
+
+// XYYX returns a vector made of the elements XYYX of this one, like
+// the GLSL swizzle v.xyyx.
+func (v Vec4) XYYX() Vec4 {
+	return Vec4{v[0], v[1], v[1], v[0]}
+}
+
+// XYYY returns a vector made of the elements XYYY of this one, like
+// the GLSL swizzle v.xyyy.
+func (v Vec4) XYYY() Vec4 {
+	return Vec4{v[0], v[1], v[1], v[1]}
+}
+
+// XYYZ returns a vector made of the elements XYYZ of this one, like
+// the GLSL swizzle v.xyyz.
+func (v Vec4) XYYZ() Vec4 {
+	return Vec4{v[0], v[1], v[1], v[2]}
+}
+
+// XYYW returns a vector made of the elements XYYW of this one, like
+// the GLSL swizzle v.xyyw.
+func (v Vec4) XYYW() Vec4 {
+	return Vec4{v[0], v[1], v[1], v[3]}
+}
+
+// XYZX returns a vector made of the elements XYZX of this one, like
+// the GLSL swizzle v.xyzx.
+func (v Vec4) XYZX() Vec4 {
+	return Vec4{v[0], v[1], v[2], v[0]}
+}
+
+// XYZY returns a vector made of the elements XYZY of this one, like
+// the GLSL swizzle v.xyzy.
+func (v Vec4) XYZY() Vec4 {
+	return Vec4{v[0], v[1], v[2], v[1]}
+}
+
+// XYZZ returns a vector made of the elements XYZZ of this one, like
+// the GLSL swizzle v.xyzz.
+func (v Vec4) XYZZ() Vec4 {
+	return Vec4{v[0], v[1], v[2], v[2]}
+}
+
+// XYZW returns a vector made of the elements XYZW of this one, like
+// the GLSL swizzle v.xyzw.
+func (v Vec4) XYZW() Vec4 {
+	return Vec4{v[0], v[1], v[2], v[3]}
+}
+
+// XYWX returns a vector made of the elements XYWX of this one, like
+// the GLSL swizzle v.xywx.
+func (v Vec4) XYWX() Vec4 {
+	return Vec4{v[0], v[1], v[3], v[0]}
+}
+
+// XYWY returns a vector made of the elements XYWY of this one, like
+// the GLSL swizzle v.xywy.
+func (v Vec4) XYWY() Vec4 {
+	return Vec4{v[0], v[1], v[3], v[1]}
+}
+
+// XYWZ returns a vector made of the elements XYWZ of this one, like
+// the GLSL swizzle v.xywz.
+func (v Vec4) XYWZ() Vec4 {
+	return Vec4{v[0], v[1], v[3], v[2]}
+}
+
+// XYWW returns a vector made of the elements XYWW of this one, like
+// the GLSL swizzle v.xyww.
+func (v Vec4) XYWW() Vec4 {
+	return Vec4{v[0], v[1], v[3], v[3]}
+}
+
+// XZXX returns a vector made of the elements XZXX of this one, like
+// the GLSL swizzle v.xzxx.
+func (v Vec4) XZXX() Vec4 {
+	return Vec4{v[0], v[2], v[0], v[0]}
+}
+
+// XZXY returns a vector made of the elements XZXY of this one, like
+// the GLSL swizzle v.xzxy.
+func (v Vec4) XZXY() Vec4 {
+	return Vec4{v[0], v[2], v[0], v[1]}
+}
+
+// XZXZ returns a vector made of the elements XZXZ of this one, like
+// the GLSL swizzle v.xzxz.
+func (v Vec4) XZXZ() Vec4 {
+	return Vec4{v[0], v[2], v[0], v[2]}
+}
+
+// XZXW returns a vector made of the elements XZXW of this one, like
+// the GLSL swizzle v.xzxw.
+func (v Vec4) XZXW() Vec4 {
+	return Vec4{v[0], v[2], v[0], v[3]}
+}
+
+// XZYX returns a vector made of the elements XZYX of this one, like
+// the GLSL swizzle v.xzyx.
+func (v Vec4) XZYX() Vec4 {
+	return Vec4{v[0], v[2], v[1], v[0]}
+}
+
+// XZYY returns a vector made of the elements XZYY of this one, like
+// the GLSL swizzle v.xzyy.
+func (v Vec4) XZYY() Vec4 {
+	return Vec4{v[0], v[2], v[1], v[1]}
+}
+
+// XZYZ returns a vector made of the elements XZYZ of this one, like
+// the GLSL swizzle v.xzyz.
+func (v Vec4) XZYZ() Vec4 {
+	return Vec4{v[0], v[2], v[1], v[2]}
+}
+
+// XZYW returns a vector made of the elements XZYW of this one, like
+// the GLSL swizzle v.xzyw.
+func (v Vec4) XZYW() Vec4 {
+	return Vec4{v[0], v[2], v[1], v[3]}
+}
+
+// XZZX returns a vector made of the elements XZZX of this one, like
+// the GLSL swizzle v.xzzx.
+func (v Vec4) XZZX() Vec4 {
+	return Vec4{v[0], v[2], v[2], v[0]}
+}
+
+// XZZY returns a vector made of the elements XZZY of this one, like
+// the GLSL swizzle v.xzzy.
+func (v Vec4) XZZY() Vec4 {
+	return Vec4{v[0], v[2], v[2], v[1]}
+}
+
+// XZZZ returns a vector made of the elements XZZZ of this one, like
+// the GLSL swizzle v.xzzz.
+func (v Vec4) XZZZ() Vec4 {
+	return Vec4{v[0], v[2], v[2], v[2]}
+}
+
+// XZZW returns a vector made of the elements XZZW of this one, like
+// the GLSL swizzle v.xzzw.
+func (v Vec4) XZZW() Vec4 {
+	return Vec4{v[0], v[2], v[2], v[3]}
+}
+
+// XZWX returns a vector made of the elements XZWX of this one, like
+// the GLSL swizzle v.xzwx.
+func (v Vec4) XZWX() Vec4 {
+	return Vec4{v[0], v[2], v[3], v[0]}
+}
+
+// XZWY returns a vector made of the elements XZWY of this one, like
+// the GLSL swizzle v.xzwy.
+func (v Vec4) XZWY() Vec4 {
+	return Vec4{v[0], v[2], v[3], v[1]}
+}
+
+// XZWZ returns a vector made of the elements XZWZ of this one, like
+// the GLSL swizzle v.xzwz.
+func (v Vec4) XZWZ() Vec4 {
+	return Vec4{v[0], v[2], v[3], v[2]}
+}
+
+// XZWW returns a vector made of the elements XZWW of this one, like
+// the GLSL swizzle v.xzww.
+func (v Vec4) XZWW() Vec4 {
+	return Vec4{v[0], v[2], v[3], v[3]}
+}
+
+// XWXX returns a vector made of the elements XWXX of this one, like
+// the GLSL swizzle v.xwxx.
+func (v Vec4) XWXX() Vec4 {
+	return Vec4{v[0], v[3], v[0], v[0]}
+}
+
+// XWXY returns a vector made of the elements XWXY of this one, like
+// the GLSL swizzle v.xwxy.
+func (v Vec4) XWXY() Vec4 {
+	return Vec4{v[0], v[3], v[0], v[1]}
+}
+
+// XWXZ returns a vector made of the elements XWXZ of this one, like
+// the GLSL swizzle v.xwxz.
+func (v Vec4) XWXZ() Vec4 {
+	return Vec4{v[0], v[3], v[0], v[2]}
+}
+
+// XWXW returns a vector made of the elements XWXW of this one, like
+// the GLSL swizzle v.xwxw.
+func (v Vec4) XWXW() Vec4 {
+	return Vec4{v[0], v[3], v[0], v[3]}
+}
+
+// XWYX returns a vector made of the elements XWYX of this one, like
+// the GLSL swizzle v.xwyx.
+func (v Vec4) XWYX() Vec4 {
+	return Vec4{v[0], v[3], v[1], v[0]}
+}
+
+// XWYY returns a vector made of the elements XWYY of this one, like
+// the GLSL swizzle v.xwyy.
+func (v Vec4) XWYY() Vec4 {
+	return Vec4{v[0], v[3], v[1], v[1]}
+}
+
+// XWYZ returns a vector made of the elements XWYZ of this one, like
+// the GLSL swizzle v.xwyz.
+func (v Vec4) XWYZ() Vec4 {
+	return Vec4{v[0], v[3], v[1], v[2]}
+}
+
+// XWYW returns a vector made of the elements XWYW of this one, like
+// the GLSL swizzle v.xwyw.
+func (v Vec4) XWYW() Vec4 {
+	return Vec4{v[0], v[3], v[1], v[3]}
+}
+
+// XWZX returns a vector made of the elements XWZX of this one, like
+// the GLSL swizzle v.xwzx.
+func (v Vec4) XWZX() Vec4 {
+	return Vec4{v[0], v[3], v[2], v[0]}
+}
+
+// XWZY returns a vector made of the elements XWZY of this one, like
+// the GLSL swizzle v.xwzy.
+func (v Vec4) XWZY() Vec4 {
+	return Vec4{v[0], v[3], v[2], v[1]}
+}
+
+// XWZZ returns a vector made of the elements XWZZ of this one, like
+// the GLSL swizzle v.xwzz.
+func (v Vec4) XWZZ() Vec4 {
+	return Vec4{v[0], v[3], v[2], v[2]}
+}
+
+// XWZW returns a vector made of the elements XWZW of this one, like
+// the GLSL swizzle v.xwzw.
+func (v Vec4) XWZW() Vec4 {
+	return Vec4{v[0], v[3], v[2], v[3]}
+}
+
+// XWWX returns a vector made of the elements XWWX of this one, like
+// the GLSL swizzle v.xwwx.
+func (v Vec4) XWWX() Vec4 {
+	return Vec4{v[0], v[3], v[3], v[0]}
+}
+
+// XWWY returns a vector made of the elements XWWY of this one, like
+// the GLSL swizzle v.xwwy.
+func (v Vec4) XWWY() Vec4 {
+	return Vec4{v[0], v[3], v[3], v[1]}
+}
+
+// XWWZ returns a vector made of the elements XWWZ of this one, like
+// the GLSL swizzle v.xwwz.
+func (v Vec4) XWWZ() Vec4 {
+	return Vec4{v[0], v[3], v[3], v[2]}
+}
+
+// XWWW returns a vector made of the elements XWWW of this one, like
+// the GLSL swizzle v.xwww.
+func (v Vec4) XWWW() Vec4 {
+	return Vec4{v[0], v[3], v[3], v[3]}
+}
+
+// YXXX returns a vector made of the elements YXXX of this one, like
+// the GLSL swizzle v.yxxx.
+func (v Vec4) YXXX() Vec4 {
+	return Vec4{v[1], v[0], v[0], v[0]}
+}
+
+// YXXY returns a vector made of the elements YXXY of this one, like
+// the GLSL swizzle v.yxxy.
+func (v Vec4) YXXY() Vec4 {
+	return Vec4{v[1], v[0], v[0], v[1]}
+}
+
+// YXXZ returns a vector made of the elements YXXZ of this one, like
+// the GLSL swizzle v.yxxz.
+func (v Vec4) YXXZ() Vec4 {
+	return Vec4{v[1], v[0], v[0], v[2]}
+}
+
+// YXXW returns a vector made of the elements YXXW of this one, like
+// the GLSL swizzle v.yxxw.
+func (v Vec4) YXXW() Vec4 {
+	return Vec4{v[1], v[0], v[0], v[3]}
+}
+
+// YXYX returns a vector made of the elements YXYX of this one, like
+// the GLSL swizzle v.yxyx.
+func (v Vec4) YXYX() Vec4 {
+	return Vec4{v[1], v[0], v[1], v[0]}
+}
+
+// YXYY returns a vector made of the elements YXYY of this one, like
+// the GLSL swizzle v.yxyy.
+func (v Vec4) YXYY() Vec4 {
+	return Vec4{v[1], v[0], v[1], v[1]}
+}
+
+// YXYZ returns a vector made of the elements YXYZ of this one, like
+// the GLSL swizzle v.yxyz.
+func (v Vec4) YXYZ() Vec4 {
+	return Vec4{v[1], v[0], v[1], v[2]}
+}
+
+// YXYW returns a vector made of the elements YXYW of this one, like
+// the GLSL swizzle v.yxyw.
+func (v Vec4) YXYW() Vec4 {
+	return Vec4{v[1], v[0], v[1], v[3]}
+}
+
+// YXZX returns a vector made of the elements YXZX of this one, like
+// the GLSL swizzle v.yxzx.
+func (v Vec4) YXZX() Vec4 {
+	return Vec4{v[1], v[0], v[2], v[0]}
+}
+
+// YXZY returns a vector made of the elements YXZY of this one, like
+// the GLSL swizzle v.yxzy.
+func (v Vec4) YXZY() Vec4 {
+	return Vec4{v[1], v[0], v[2], v[1]}
+}
+
+// YXZZ returns a vector made of the elements YXZZ of this one, like
+// the GLSL swizzle v.yxzz.
+func (v Vec4) YXZZ() Vec4 {
+	return Vec4{v[1], v[0], v[2], v[2]}
+}
+
+// YXZW returns a vector made of the elements YXZW of this one, like
+// the GLSL swizzle v.yxzw.
+func (v Vec4) YXZW() Vec4 {
+	return Vec4{v[1], v[0], v[2], v[3]}
+}
+
+// YXWX returns a vector made of the elements YXWX of this one, like
+// the GLSL swizzle v.yxwx.
+func (v Vec4) YXWX() Vec4 {
+	return Vec4{v[1], v[0], v[3], v[0]}
+}
+
+// YXWY returns a vector made of the elements YXWY of this one, like
+// the GLSL swizzle v.yxwy.
+func (v Vec4) YXWY() Vec4 {
+	return Vec4{v[1], v[0], v[3], v[1]}
+}
+
+// YXWZ returns a vector made of the elements YXWZ of this one, like
+// the GLSL swizzle v.yxwz.
+func (v Vec4) YXWZ() Vec4 {
+	return Vec4{v[1], v[0], v[3], v[2]}
+}
+
+// YXWW returns a vector made of the elements YXWW of this one, like
+// the GLSL swizzle v.yxww.
+func (v Vec4) YXWW() Vec4 {
+	return Vec4{v[1], v[0], v[3], v[3]}
+}
+
+// YYXX returns a vector made of the elements YYXX of this one, like
+// the GLSL swizzle v.yyxx.
+func (v Vec4) YYXX() Vec4 {
+	return Vec4{v[1], v[1], v[0], v[0]}
+}
+
+// YYXY returns a vector made of the elements YYXY of this one, like
+// the GLSL swizzle v.yyxy.
+func (v Vec4) YYXY() Vec4 {
+	return Vec4{v[1], v[1], v[0], v[1]}
+}
+
+// YYXZ returns a vector made of the elements YYXZ of this one, like
+// the GLSL swizzle v.yyxz.
+func (v Vec4) YYXZ() Vec4 {
+	return Vec4{v[1], v[1], v[0], v[2]}
+}
+
+// YYXW returns a vector made of the elements YYXW of this one, like
+// the GLSL swizzle v.yyxw.
+func (v Vec4) YYXW() Vec4 {
+	return Vec4{v[1], v[1], v[0], v[3]}
+}
+
+// YYYX returns a vector made of the elements YYYX of this one, like
+// the GLSL swizzle v.yyyx.
+func (v Vec4) YYYX() Vec4 {
+	return Vec4{v[1], v[1], v[1], v[0]}
+}
+
+// YYYY returns a vector made of the elements YYYY of this one, like
+// the GLSL swizzle v.yyyy.
+func (v Vec4) YYYY() Vec4 {
+	return Vec4{v[1], v[1], v[1], v[1]}
+}
+
+// YYYZ returns a vector made of the elements YYYZ of this one, like
+// the GLSL swizzle v.yyyz.
+func (v Vec4) YYYZ() Vec4 {
+	return Vec4{v[1], v[1], v[1], v[2]}
+}
+
+// YYYW returns a vector made of the elements YYYW of this one, like
+// the GLSL swizzle v.yyyw.
+func (v Vec4) YYYW() Vec4 {
+	return Vec4{v[1], v[1], v[1], v[3]}
+}
+
+// YYZX returns a vector made of the elements YYZX of this one, like
+// the GLSL swizzle v.yyzx.
+func (v Vec4) YYZX() Vec4 {
+	return Vec4{v[1], v[1], v[2], v[0]}
+}
+
+// YYZY returns a vector made of the elements YYZY of this one, like
+// the GLSL swizzle v.yyzy.
+func (v Vec4) YYZY() Vec4 {
+	return Vec4{v[1], v[1], v[2], v[1]}
+}
+
+// YYZZ returns a vector made of the elements YYZZ of this one, like
+// the GLSL swizzle v.yyzz.
+func (v Vec4) YYZZ() Vec4 {
+	return Vec4{v[1], v[1], v[2], v[2]}
+}
+
+// YYZW returns a vector made of the elements YYZW of this one, like
+// the GLSL swizzle v.yyzw.
+func (v Vec4) YYZW() Vec4 {
+	return Vec4{v[1], v[1], v[2], v[3]}
+}
+
+// YYWX returns a vector made of the elements YYWX of this one, like
+// the GLSL swizzle v.yywx.
+func (v Vec4) YYWX() Vec4 {
+	return Vec4{v[1], v[1], v[3], v[0]}
+}
+
+// YYWY returns a vector made of the elements YYWY of this one, like
+// the GLSL swizzle v.yywy.
+func (v Vec4) YYWY() Vec4 {
+	return Vec4{v[1], v[1], v[3], v[1]}
+}
+
+// YYWZ returns a vector made of the elements YYWZ of this one, like
+// the GLSL swizzle v.yywz.
+func (v Vec4) YYWZ() Vec4 {
+	return Vec4{v[1], v[1], v[3], v[2]}
+}
+
+// YYWW returns a vector made of the elements YYWW of this one, like
+// the GLSL swizzle v.yyww.
+func (v Vec4) YYWW() Vec4 {
+	return Vec4{v[1], v[1], v[3], v[3]}
+}
+
+// YZXX returns a vector made of the elements YZXX of this one, like
+// the GLSL swizzle v.yzxx.
+func (v Vec4) YZXX() Vec4 {
+	return Vec4{v[1], v[2], v[0], v[0]}
+}
+
+// YZXY returns a vector made of the elements YZXY of this one, like
+// the GLSL swizzle v.yzxy.
+func (v Vec4) YZXY() Vec4 {
+	return Vec4{v[1], v[2], v[0], v[1]}
+}
+
+// YZXZ returns a vector made of the elements YZXZ of this one, like
+// the GLSL swizzle v.yzxz.
+func (v Vec4) YZXZ() Vec4 {
+	return Vec4{v[1], v[2], v[0], v[2]}
+}
+
+// YZXW returns a vector made of the elements YZXW of this one, like
+// the GLSL swizzle v.yzxw.
+func (v Vec4) YZXW() Vec4 {
+	return Vec4{v[1], v[2], v[0], v[3]}
+}
+
+// YZYX returns a vector made of the elements YZYX of this one, like
+// the GLSL swizzle v.yzyx.
+func (v Vec4) YZYX() Vec4 {
+	return Vec4{v[1], v[2], v[1], v[0]}
+}
+
+// YZYY returns a vector made of the elements YZYY of this one, like
+// the GLSL swizzle v.yzyy.
+func (v Vec4) YZYY() Vec4 {
+	return Vec4{v[1], v[2], v[1], v[1]}
+}
+
+// YZYZ returns a vector made of the elements YZYZ of this one, like
+// the GLSL swizzle v.yzyz.
+func (v Vec4) YZYZ() Vec4 {
+	return Vec4{v[1], v[2], v[1], v[2]}
+}
+
+// YZYW returns a vector made of the elements YZYW of this one, like
+// the GLSL swizzle v.yzyw.
+func (v Vec4) YZYW() Vec4 {
+	return Vec4{v[1], v[2], v[1], v[3]}
+}
+
+// YZZX returns a vector made of the elements YZZX of this one, like
+// the GLSL swizzle v.yzzx.
+func (v Vec4) YZZX() Vec4 {
+	return Vec4{v[1], v[2], v[2], v[0]}
+}
+
+// YZZY returns a vector made of the elements YZZY of this one, like
+// the GLSL swizzle v.yzzy.
+func (v Vec4) YZZY() Vec4 {
+	return Vec4{v[1], v[2], v[2], v[1]}
+}
+
+// YZZZ returns a vector made of the elements YZZZ of this one, like
+// the GLSL swizzle v.yzzz.
+func (v Vec4) YZZZ() Vec4 {
+	return Vec4{v[1], v[2], v[2], v[2]}
+}
+
+// YZZW returns a vector made of the elements YZZW of this one, like
+// the GLSL swizzle v.yzzw.
+func (v Vec4) YZZW() Vec4 {
+	return Vec4{v[1], v[2], v[2], v[3]}
+}
+
+// YZWX returns a vector made of the elements YZWX of this one, like
+// the GLSL swizzle v.yzwx.
+func (v Vec4) YZWX() Vec4 {
+	return Vec4{v[1], v[2], v[3], v[0]}
+}
+
+// YZWY returns a vector made of the elements YZWY of this one, like
+// the GLSL swizzle v.yzwy.
+func (v Vec4) YZWY() Vec4 {
+	return Vec4{v[1], v[2], v[3], v[1]}
+}
+
+// YZWZ returns a vector made of the elements YZWZ of this one, like
+// the GLSL swizzle v.yzwz.
+func (v Vec4) YZWZ() Vec4 {
+	return Vec4{v[1], v[2], v[3], v[2]}
+}
+
+// YZWW returns a vector made of the elements YZWW of this one, like
+// the GLSL swizzle v.yzww.
+func (v Vec4) YZWW() Vec4 {
+	return Vec4{v[1], v[2], v[3], v[3]}
+}
+
+// YWXX returns a vector made of the elements YWXX of this one, like
+// the GLSL swizzle v.ywxx.
+func (v Vec4) YWXX() Vec4 {
+	return Vec4{v[1], v[3], v[0], v[0]}
+}
+
+// YWXY returns a vector made of the elements YWXY of this one, like
+// the GLSL swizzle v.ywxy.
+func (v Vec4) YWXY() Vec4 {
+	return Vec4{v[1], v[3], v[0], v[1]}
+}
+
+// YWXZ returns a vector made of the elements YWXZ of this one, like
+// the GLSL swizzle v.ywxz.
+func (v Vec4) YWXZ() Vec4 {
+	return Vec4{v[1], v[3], v[0], v[2]}
+}
+
+// YWXW returns a vector made of the elements YWXW of this one, like
+// the GLSL swizzle v.ywxw.
+func (v Vec4) YWXW() Vec4 {
+	return Vec4{v[1], v[3], v[0], v[3]}
+}
+
+// YWYX returns a vector made of the elements YWYX of this one, like
+// the GLSL swizzle v.ywyx.
+func (v Vec4) YWYX() Vec4 {
+	return Vec4{v[1], v[3], v[1], v[0]}
+}
+
+// YWYY returns a vector made of the elements YWYY of this one, like
+// the GLSL swizzle v.ywyy.
+func (v Vec4) YWYY() Vec4 {
+	return Vec4{v[1], v[3], v[1], v[1]}
+}
+
+// YWYZ returns a vector made of the elements YWYZ of this one, like
+// the GLSL swizzle v.ywyz.
+func (v Vec4) YWYZ() Vec4 {
+	return Vec4{v[1], v[3], v[1], v[2]}
+}
+
+// YWYW returns a vector made of the elements YWYW of this one, like
+// the GLSL swizzle v.ywyw.
+func (v Vec4) YWYW() Vec4 {
+	return Vec4{v[1], v[3], v[1], v[3]}
+}
+
+// YWZX returns a vector made of the elements YWZX of this one, like
+// the GLSL swizzle v.ywzx.
+func (v Vec4) YWZX() Vec4 {
+	return Vec4{v[1], v[3], v[2], v[0]}
+}
+
+// YWZY returns a vector made of the elements YWZY of this one, like
+// the GLSL swizzle v.ywzy.
+func (v Vec4) YWZY() Vec4 {
+	return Vec4{v[1], v[3], v[2], v[1]}
+}
+
+// YWZZ returns a vector made of the elements YWZZ of this one, like
+// the GLSL swizzle v.ywzz.
+func (v Vec4) YWZZ() Vec4 {
+	return Vec4{v[1], v[3], v[2], v[2]}
+}
+
+// YWZW returns a vector made of the elements YWZW of this one, like
+// the GLSL swizzle v.ywzw.
+func (v Vec4) YWZW() Vec4 {
+	return Vec4{v[1], v[3], v[2], v[3]}
+}
+
+// YWWX returns a vector made of the elements YWWX of this one, like
+// the GLSL swizzle v.ywwx.
+func (v Vec4) YWWX() Vec4 {
+	return Vec4{v[1], v[3], v[3], v[0]}
+}
+
+// YWWY returns a vector made of the elements YWWY of this one, like
+// the GLSL swizzle v.ywwy.
+func (v Vec4) YWWY() Vec4 {
+	return Vec4{v[1], v[3], v[3], v[1]}
+}
+
+// YWWZ returns a vector made of the elements YWWZ of this one, like
+// the GLSL swizzle v.ywwz.
+func (v Vec4) YWWZ() Vec4 {
+	return Vec4{v[1], v[3], v[3], v[2]}
+}
+
+// YWWW returns a vector made of the elements YWWW of this one, like
+// the GLSL swizzle v.ywww.
+func (v Vec4) YWWW() Vec4 {
+	return Vec4{v[1], v[3], v[3], v[3]}
+}
+
+// ZXXX returns a vector made of the elements ZXXX of this one, like
+// the GLSL swizzle v.zxxx.
+func (v Vec4) ZXXX() Vec4 {
+	return Vec4{v[2], v[0], v[0], v[0]}
+}
+
+// ZXXY returns a vector made of the elements ZXXY of this one, like
+// the GLSL swizzle v.zxxy.
+func (v Vec4) ZXXY() Vec4 {
+	return Vec4{v[2], v[0], v[0], v[1]}
+}
+
+// ZXXZ returns a vector made of the elements ZXXZ of this one, like
+// the GLSL swizzle v.zxxz.
+func (v Vec4) ZXXZ() Vec4 {
+	return Vec4{v[2], v[0], v[0], v[2]}
+}
+
+// ZXXW returns a vector made of the elements ZXXW of this one, like
+// the GLSL swizzle v.zxxw.
+func (v Vec4) ZXXW() Vec4 {
+	return Vec4{v[2], v[0], v[0], v[3]}
+}
+
+// ZXYX returns a vector made of the elements ZXYX of this one, like
+// the GLSL swizzle v.zxyx.
+func (v Vec4) ZXYX() Vec4 {
+	return Vec4{v[2], v[0], v[1], v[0]}
+}
+
+// ZXYY returns a vector made of the elements ZXYY of this one, like
+// the GLSL swizzle v.zxyy.
+func (v Vec4) ZXYY() Vec4 {
+	return Vec4{v[2], v[0], v[1], v[1]}
+}
+
+// ZXYZ returns a vector made of the elements ZXYZ of this one, like
+// the GLSL swizzle v.zxyz.
+func (v Vec4) ZXYZ() Vec4 {
+	return Vec4{v[2], v[0], v[1], v[2]}
+}
+
+// ZXYW returns a vector made of the elements ZXYW of this one, like
+// the GLSL swizzle v.zxyw.
+func (v Vec4) ZXYW() Vec4 {
+	return Vec4{v[2], v[0], v[1], v[3]}
+}
+
+// ZXZX returns a vector made of the elements ZXZX of this one, like
+// the GLSL swizzle v.zxzx.
+func (v Vec4) ZXZX() Vec4 {
+	return Vec4{v[2], v[0], v[2], v[0]}
+}
+
+// ZXZY returns a vector made of the elements ZXZY of this one, like
+// the GLSL swizzle v.zxzy.
+func (v Vec4) ZXZY() Vec4 {
+	return Vec4{v[2], v[0], v[2], v[1]}
+}
+
+// ZXZZ returns a vector made of the elements ZXZZ of this one, like
+// the GLSL swizzle v.zxzz.
+func (v Vec4) ZXZZ() Vec4 {
+	return Vec4{v[2], v[0], v[2], v[2]}
+}
+
+// ZXZW returns a vector made of the elements ZXZW of this one, like
+// the GLSL swizzle v.zxzw.
+func (v Vec4) ZXZW() Vec4 {
+	return Vec4{v[2], v[0], v[2], v[3]}
+}
+
+// ZXWX returns a vector made of the elements ZXWX of this one, like
+// the GLSL swizzle v.zxwx.
+func (v Vec4) ZXWX() Vec4 {
+	return Vec4{v[2], v[0], v[3], v[0]}
+}
+
+// ZXWY returns a vector made of the elements ZXWY of this one, like
+// the GLSL swizzle v.zxwy.
+func (v Vec4) ZXWY() Vec4 {
+	return Vec4{v[2], v[0], v[3], v[1]}
+}
+
+// ZXWZ returns a vector made of the elements ZXWZ of this one, like
+// the GLSL swizzle v.zxwz.
+func (v Vec4) ZXWZ() Vec4 {
+	return Vec4{v[2], v[0], v[3], v[2]}
+}
+
+// ZXWW returns a vector made of the elements ZXWW of this one, like
+// the GLSL swizzle v.zxww.
+func (v Vec4) ZXWW() Vec4 {
+	return Vec4{v[2], v[0], v[3], v[3]}
+}
+
+// ZYXX returns a vector made of the elements ZYXX of this one, like
+// the GLSL swizzle v.zyxx.
+func (v Vec4) ZYXX() Vec4 {
+	return Vec4{v[2], v[1], v[0], v[0]}
+}
+
+// ZYXY returns a vector made of the elements ZYXY of this one, like
+// the GLSL swizzle v.zyxy.
+func (v Vec4) ZYXY() Vec4 {
+	return Vec4{v[2], v[1], v[0], v[1]}
+}
+
+// ZYXZ returns a vector made of the elements ZYXZ of this one, like
+// the GLSL swizzle v.zyxz.
+func (v Vec4) ZYXZ() Vec4 {
+	return Vec4{v[2], v[1], v[0], v[2]}
+}
+
+// ZYXW returns a vector made of the elements ZYXW of this one, like
+// the GLSL swizzle v.zyxw.
+func (v Vec4) ZYXW() Vec4 {
+	return Vec4{v[2], v[1], v[0], v[3]}
+}
+
+// ZYYX returns a vector made of the elements ZYYX of this one, like
+// the GLSL swizzle v.zyyx.
+func (v Vec4) ZYYX() Vec4 {
+	return Vec4{v[2], v[1], v[1], v[0]}
+}
+
+// ZYYY returns a vector made of the elements ZYYY of this one, like
+// the GLSL swizzle v.zyyy.
+func (v Vec4) ZYYY() Vec4 {
+	return Vec4{v[2], v[1], v[1], v[1]}
+}
+
+// ZYYZ returns a vector made of the elements ZYYZ of this one, like
+// the GLSL swizzle v.zyyz.
+func (v Vec4) ZYYZ() Vec4 {
+	return Vec4{v[2], v[1], v[1], v[2]}
+}
+
+// ZYYW returns a vector made of the elements ZYYW of this one, like
+// the GLSL swizzle v.zyyw.
+func (v Vec4) ZYYW() Vec4 {
+	return Vec4{v[2], v[1], v[1], v[3]}
+}
+
+// ZYZX returns a vector made of the elements ZYZX of this one, like
+// the GLSL swizzle v.zyzx.
+func (v Vec4) ZYZX() Vec4 {
+	return Vec4{v[2], v[1], v[2], v[0]}
+}
+
+// ZYZY returns a vector made of the elements ZYZY of this one, like
+// the GLSL swizzle v.zyzy.
+func (v Vec4) ZYZY() Vec4 {
+	return Vec4{v[2], v[1], v[2], v[1]}
+}
+
+// ZYZZ returns a vector made of the elements ZYZZ of this one, like
+// the GLSL swizzle v.zyzz.
+func (v Vec4) ZYZZ() Vec4 {
+	return Vec4{v[2], v[1], v[2], v[2]}
+}
+
+// ZYZW returns a vector made of the elements ZYZW of this one, like
+// the GLSL swizzle v.zyzw.
+func (v Vec4) ZYZW() Vec4 {
+	return Vec4{v[2], v[1], v[2], v[3]}
+}
+
+// ZYWX returns a vector made of the elements ZYWX of this one, like
+// the GLSL swizzle v.zywx.
+func (v Vec4) ZYWX() Vec4 {
+	return Vec4{v[2], v[1], v[3], v[0]}
+}
+
+// ZYWY returns a vector made of the elements ZYWY of this one, like
+// the GLSL swizzle v.zywy.
+func (v Vec4) ZYWY() Vec4 {
+	return Vec4{v[2], v[1], v[3], v[1]}
+}
+
+// ZYWZ returns a vector made of the elements ZYWZ of this one, like
+// the GLSL swizzle v.zywz.
+func (v Vec4) ZYWZ() Vec4 {
+	return Vec4{v[2], v[1], v[3], v[2]}
+}
+
+// ZYWW returns a vector made of the elements ZYWW of this one, like
+// the GLSL swizzle v.zyww.
+func (v Vec4) ZYWW() Vec4 {
+	return Vec4{v[2], v[1], v[3], v[3]}
+}
+
+// ZZXX returns a vector made of the elements ZZXX of this one, like
+// the GLSL swizzle v.zzxx.
+func (v Vec4) ZZXX() Vec4 {
+	return Vec4{v[2], v[2], v[0], v[0]}
+}
+
+// ZZXY returns a vector made of the elements ZZXY of this one, like
+// the GLSL swizzle v.zzxy.
+func (v Vec4) ZZXY() Vec4 {
+	return Vec4{v[2], v[2], v[0], v[1]}
+}
+
+// ZZXZ returns a vector made of the elements ZZXZ of this one, like
+// the GLSL swizzle v.zzxz.
+func (v Vec4) ZZXZ() Vec4 {
+	return Vec4{v[2], v[2], v[0], v[2]}
+}
+
+// ZZXW returns a vector made of the elements ZZXW of this one, like
+// the GLSL swizzle v.zzxw.
+func (v Vec4) ZZXW() Vec4 {
+	return Vec4{v[2], v[2], v[0], v[3]}
+}
+
+// ZZYX returns a vector made of the elements ZZYX of this one, like
+// the GLSL swizzle v.zzyx.
+func (v Vec4) ZZYX() Vec4 {
+	return Vec4{v[2], v[2], v[1], v[0]}
+}
+
+// ZZYY returns a vector made of the elements ZZYY of this one, like
+// the GLSL swizzle v.zzyy.
+func (v Vec4) ZZYY() Vec4 {
+	return Vec4{v[2], v[2], v[1], v[1]}
+}
+
+// ZZYZ returns a vector made of the elements ZZYZ of this one, like
+// the GLSL swizzle v.zzyz.
+func (v Vec4) ZZYZ() Vec4 {
+	return Vec4{v[2], v[2], v[1], v[2]}
+}
+
+// ZZYW returns a vector made of the elements ZZYW of this one, like
+// the GLSL swizzle v.zzyw.
+func (v Vec4) ZZYW() Vec4 {
+	return Vec4{v[2], v[2], v[1], v[3]}
+}
+
+// ZZZX returns a vector made of the elements ZZZX of this one, like
+// the GLSL swizzle v.zzzx.
+func (v Vec4) ZZZX() Vec4 {
+	return Vec4{v[2], v[2], v[2], v[0]}
+}
+
+// ZZZY returns a vector made of the elements ZZZY of this one, like
+// the GLSL swizzle v.zzzy.
+func (v Vec4) ZZZY() Vec4 {
+	return Vec4{v[2], v[2], v[2], v[1]}
+}
+
+// ZZZZ returns a vector made of the elements ZZZZ of this one, like
+// the GLSL swizzle v.zzzz.
+func (v Vec4) ZZZZ() Vec4 {
+	return Vec4{v[2], v[2], v[2], v[2]}
+}
+
+// ZZZW returns a vector made of the elements ZZZW of this one, like
+// the GLSL swizzle v.zzzw.
+func (v Vec4) ZZZW() Vec4 {
+	return Vec4{v[2], v[2], v[2], v[3]}
+}
+
+// ZZWX returns a vector made of the elements ZZWX of this one, like
+// the GLSL swizzle v.zzwx.
+func (v Vec4) ZZWX() Vec4 {
+	return Vec4{v[2], v[2], v[3], v[0]}
+}
+
+// ZZWY returns a vector made of the elements ZZWY of this one, like
+// the GLSL swizzle v.zzwy.
+func (v Vec4) ZZWY() Vec4 {
+	return Vec4{v[2], v[2], v[3], v[1]}
+}
+
+// ZZWZ returns a vector made of the elements ZZWZ of this one, like
+// the GLSL swizzle v.zzwz.
+func (v Vec4) ZZWZ() Vec4 {
+	return Vec4{v[2], v[2], v[3], v[2]}
+}
+
+// ZZWW returns a vector made of the elements ZZWW of this one, like
+// the GLSL swizzle v.zzww.
+func (v Vec4) ZZWW() Vec4 {
+	return Vec4{v[2], v[2], v[3], v[3]}
+}
+
+// ZWXX returns a vector made of the elements ZWXX of this one, like
+// the GLSL swizzle v.zwxx.
+func (v Vec4) ZWXX() Vec4 {
+	return Vec4{v[2], v[3], v[0], v[0]}
+}
+
+// ZWXY returns a vector made of the elements ZWXY of this one, like
+// the GLSL swizzle v.zwxy.
+func (v Vec4) ZWXY() Vec4 {
+	return Vec4{v[2], v[3], v[0], v[1]}
+}
+
+// ZWXZ returns a vector made of the elements ZWXZ of this one, like
+// the GLSL swizzle v.zwxz.
+func (v Vec4) ZWXZ() Vec4 {
+	return Vec4{v[2], v[3], v[0], v[2]}
+}
+
+// ZWXW returns a vector made of the elements ZWXW of this one, like
+// the GLSL swizzle v.zwxw.
+func (v Vec4) ZWXW() Vec4 {
+	return Vec4{v[2], v[3], v[0], v[3]}
+}
+
+// ZWYX returns a vector made of the elements ZWYX of this one, like
+// the GLSL swizzle v.zwyx.
+func (v Vec4) ZWYX() Vec4 {
+	return Vec4{v[2], v[3], v[1], v[0]}
+}
+
+// ZWYY returns a vector made of the elements ZWYY of this one, like
+// the GLSL swizzle v.zwyy.
+func (v Vec4) ZWYY() Vec4 {
+	return Vec4{v[2], v[3], v[1], v[1]}
+}
+
+// ZWYZ returns a vector made of the elements ZWYZ of this one, like
+// the GLSL swizzle v.zwyz.
+func (v Vec4) ZWYZ() Vec4 {
+	return Vec4{v[2], v[3], v[1], v[2]}
+}
+
+// ZWYW returns a vector made of the elements ZWYW of this one, like
+// the GLSL swizzle v.zwyw.
+func (v Vec4) ZWYW() Vec4 {
+	return Vec4{v[2], v[3], v[1], v[3]}
+}
+
+// ZWZX returns a vector made of the elements ZWZX of this one, like
+// the GLSL swizzle v.zwzx.
+func (v Vec4) ZWZX() Vec4 {
+	return Vec4{v[2], v[3], v[2], v[0]}
+}
+
+// ZWZY returns a vector made of the elements ZWZY of this one, like
+// the GLSL swizzle v.zwzy.
+func (v Vec4) ZWZY() Vec4 {
+	return Vec4{v[2], v[3], v[2], v[1]}
+}
+
+// ZWZZ returns a vector made of the elements ZWZZ of this one, like
+// the GLSL swizzle v.zwzz.
+func (v Vec4) ZWZZ() Vec4 {
+	return Vec4{v[2], v[3], v[2], v[2]}
+}
+
+// ZWZW returns a vector made of the elements ZWZW of this one, like
+// the GLSL swizzle v.zwzw.
+func (v Vec4) ZWZW() Vec4 {
+	return Vec4{v[2], v[3], v[2], v[3]}
+}
+
+// ZWWX returns a vector made of the elements ZWWX of this one, like
+// the GLSL swizzle v.zwwx.
+func (v Vec4) ZWWX() Vec4 {
+	return Vec4{v[2], v[3], v[3], v[0]}
+}
+
+// ZWWY returns a vector made of the elements ZWWY of this one, like
+// the GLSL swizzle v.zwwy.
+func (v Vec4) ZWWY() Vec4 {
+	return Vec4{v[2], v[3], v[3], v[1]}
+}
+
+// ZWWZ returns a vector made of the elements ZWWZ of this one, like
+// the GLSL swizzle v.zwwz.
+func (v Vec4) ZWWZ() Vec4 {
+	return Vec4{v[2], v[3], v[3], v[2]}
+}
+
+// ZWWW returns a vector made of the elements ZWWW of this one, like
+// the GLSL swizzle v.zwww.
+func (v Vec4) ZWWW() Vec4 {
+	return Vec4{v[2], v[3], v[3], v[3]}
+}
+
+// WXXX returns a vector made of the elements WXXX of this one, like
+// the GLSL swizzle v.wxxx.
+func (v Vec4) WXXX() Vec4 {
+	return Vec4{v[3], v[0], v[0], v[0]}
+}
+
+// WXXY returns a vector made of the elements WXXY of this one, like
+// the GLSL swizzle v.wxxy.
+func (v Vec4) WXXY() Vec4 {
+	return Vec4{v[3], v[0], v[0], v[1]}
+}
+
+// WXXZ returns a vector made of the elements WXXZ of this one, like
+// the GLSL swizzle v.wxxz.
+func (v Vec4) WXXZ() Vec4 {
+	return Vec4{v[3], v[0], v[0], v[2]}
+}
+
+// WXXW returns a vector made of the elements WXXW of this one, like
+// the GLSL swizzle v.wxxw.
+func (v Vec4) WXXW() Vec4 {
+	return Vec4{v[3], v[0], v[0], v[3]}
+}
+
+// WXYX returns a vector made of the elements WXYX of this one, like
+// the GLSL swizzle v.wxyx.
+func (v Vec4) WXYX() Vec4 {
+	return Vec4{v[3], v[0], v[1], v[0]}
+}
+
+// WXYY returns a vector made of the elements WXYY of this one, like
+// the GLSL swizzle v.wxyy.
+func (v Vec4) WXYY() Vec4 {
+	return Vec4{v[3], v[0], v[1], v[1]}
+}
+
+// WXYZ returns a vector made of the elements WXYZ of this one, like
+// the GLSL swizzle v.wxyz.
+func (v Vec4) WXYZ() Vec4 {
+	return Vec4{v[3], v[0], v[1], v[2]}
+}
+
+// WXYW returns a vector made of the elements WXYW of this one, like
+// the GLSL swizzle v.wxyw.
+func (v Vec4) WXYW() Vec4 {
+	return Vec4{v[3], v[0], v[1], v[3]}
+}
+
+// WXZX returns a vector made of the elements WXZX of this one, like
+// the GLSL swizzle v.wxzx.
+func (v Vec4) WXZX() Vec4 {
+	return Vec4{v[3], v[0], v[2], v[0]}
+}
+
+// WXZY returns a vector made of the elements WXZY of this one, like
+// the GLSL swizzle v.wxzy.
+func (v Vec4) WXZY() Vec4 {
+	return Vec4{v[3], v[0], v[2], v[1]}
+}
+
+// WXZZ returns a vector made of the elements WXZZ of this one, like
+// the GLSL swizzle v.wxzz.
+func (v Vec4) WXZZ() Vec4 {
+	return Vec4{v[3], v[0], v[2], v[2]}
+}
+
+// WXZW returns a vector made of the elements WXZW of this one, like
+// the GLSL swizzle v.wxzw.
+func (v Vec4) WXZW() Vec4 {
+	return Vec4{v[3], v[0], v[2], v[3]}
+}
+
+// WXWX returns a vector made of the elements WXWX of this one, like
+// the GLSL swizzle v.wxwx.
+func (v Vec4) WXWX() Vec4 {
+	return Vec4{v[3], v[0], v[3], v[0]}
+}
+
+// WXWY returns a vector made of the elements WXWY of this one, like
+// the GLSL swizzle v.wxwy.
+func (v Vec4) WXWY() Vec4 {
+	return Vec4{v[3], v[0], v[3], v[1]}
+}
+
+// WXWZ returns a vector made of the elements WXWZ of this one, like
+// the GLSL swizzle v.wxwz.
+func (v Vec4) WXWZ() Vec4 {
+	return Vec4{v[3], v[0], v[3], v[2]}
+}
+
+// WXWW returns a vector made of the elements WXWW of this one, like
+// the GLSL swizzle v.wxww.
+func (v Vec4) WXWW() Vec4 {
+	return Vec4{v[3], v[0], v[3], v[3]}
+}
+
+// WYXX returns a vector made of the elements WYXX of this one, like
+// the GLSL swizzle v.wyxx.
+func (v Vec4) WYXX() Vec4 {
+	return Vec4{v[3], v[1], v[0], v[0]}
+}
+
+// WYXY returns a vector made of the elements WYXY of this one, like
+// the GLSL swizzle v.wyxy.
+func (v Vec4) WYXY() Vec4 {
+	return Vec4{v[3], v[1], v[0], v[1]}
+}
+
+// WYXZ returns a vector made of the elements WYXZ of this one, like
+// the GLSL swizzle v.wyxz.
+func (v Vec4) WYXZ() Vec4 {
+	return Vec4{v[3], v[1], v[0], v[2]}
+}
+
+// WYXW returns a vector made of the elements WYXW of this one, like
+// the GLSL swizzle v.wyxw.
+func (v Vec4) WYXW() Vec4 {
+	return Vec4{v[3], v[1], v[0], v[3]}
+}
+
+// WYYX returns a vector made of the elements WYYX of this one, like
+// the GLSL swizzle v.wyyx.
+func (v Vec4) WYYX() Vec4 {
+	return Vec4{v[3], v[1], v[1], v[0]}
+}
+
+// WYYY returns a vector made of the elements WYYY of this one, like
+// the GLSL swizzle v.wyyy.
+func (v Vec4) WYYY() Vec4 {
+	return Vec4{v[3], v[1], v[1], v[1]}
+}
+
+// WYYZ returns a vector made of the elements WYYZ of this one, like
+// the GLSL swizzle v.wyyz.
+func (v Vec4) WYYZ() Vec4 {
+	return Vec4{v[3], v[1], v[1], v[2]}
+}
+
+// WYYW returns a vector made of the elements WYYW of this one, like
+// the GLSL swizzle v.wyyw.
+func (v Vec4) WYYW() Vec4 {
+	return Vec4{v[3], v[1], v[1], v[3]}
+}
+
+// WYZX returns a vector made of the elements WYZX of this one, like
+// the GLSL swizzle v.wyzx.
+func (v Vec4) WYZX() Vec4 {
+	return Vec4{v[3], v[1], v[2], v[0]}
+}
+
+// WYZY returns a vector made of the elements WYZY of this one, like
+// the GLSL swizzle v.wyzy.
+func (v Vec4) WYZY() Vec4 {
+	return Vec4{v[3], v[1], v[2], v[1]}
+}
+
+// WYZZ returns a vector made of the elements WYZZ of this one, like
+// the GLSL swizzle v.wyzz.
+func (v Vec4) WYZZ() Vec4 {
+	return Vec4{v[3], v[1], v[2], v[2]}
+}
+
+// WYZW returns a vector made of the elements WYZW of this one, like
+// the GLSL swizzle v.wyzw.
+func (v Vec4) WYZW() Vec4 {
+	return Vec4{v[3], v[1], v[2], v[3]}
+}
+
+// WYWX returns a vector made of the elements WYWX of this one, like
+// the GLSL swizzle v.wywx.
+func (v Vec4) WYWX() Vec4 {
+	return Vec4{v[3], v[1], v[3], v[0]}
+}
+
+// WYWY returns a vector made of the elements WYWY of this one, like
+// the GLSL swizzle v.wywy.
+func (v Vec4) WYWY() Vec4 {
+	return Vec4{v[3], v[1], v[3], v[1]}
+}
+
+// WYWZ returns a vector made of the elements WYWZ of this one, like
+// the GLSL swizzle v.wywz.
+func (v Vec4) WYWZ() Vec4 {
+	return Vec4{v[3], v[1], v[3], v[2]}
+}
+
+// WYWW returns a vector made of the elements WYWW of this one, like
+// the GLSL swizzle v.wyww.
+func (v Vec4) WYWW() Vec4 {
+	return Vec4{v[3], v[1], v[3], v[3]}
+}
+
+// WZXX returns a vector made of the elements WZXX of this one, like
+// the GLSL swizzle v.wzxx.
+func (v Vec4) WZXX() Vec4 {
+	return Vec4{v[3], v[2], v[0], v[0]}
+}
+
+// WZXY returns a vector made of the elements WZXY of this one, like
+// the GLSL swizzle v.wzxy.
+func (v Vec4) WZXY() Vec4 {
+	return Vec4{v[3], v[2], v[0], v[1]}
+}
+
+// WZXZ returns a vector made of the elements WZXZ of this one, like
+// the GLSL swizzle v.wzxz.
+func (v Vec4) WZXZ() Vec4 {
+	return Vec4{v[3], v[2], v[0], v[2]}
+}
+
+// WZXW returns a vector made of the elements WZXW of this one, like
+// the GLSL swizzle v.wzxw.
+func (v Vec4) WZXW() Vec4 {
+	return Vec4{v[3], v[2], v[0], v[3]}
+}
+
+// WZYX returns a vector made of the elements WZYX of this one, like
+// the GLSL swizzle v.wzyx.
+func (v Vec4) WZYX() Vec4 {
+	return Vec4{v[3], v[2], v[1], v[0]}
+}
+
+// WZYY returns a vector made of the elements WZYY of this one, like
+// the GLSL swizzle v.wzyy.
+func (v Vec4) WZYY() Vec4 {
+	return Vec4{v[3], v[2], v[1], v[1]}
+}
+
+// WZYZ returns a vector made of the elements WZYZ of this one, like
+// the GLSL swizzle v.wzyz.
+func (v Vec4) WZYZ() Vec4 {
+	return Vec4{v[3], v[2], v[1], v[2]}
+}
+
+// WZYW returns a vector made of the elements WZYW of this one, like
+// the GLSL swizzle v.wzyw.
+func (v Vec4) WZYW() Vec4 {
+	return Vec4{v[3], v[2], v[1], v[3]}
+}
+
+// WZZX returns a vector made of the elements WZZX of this one, like
+// the GLSL swizzle v.wzzx.
+func (v Vec4) WZZX() Vec4 {
+	return Vec4{v[3], v[2], v[2], v[0]}
+}
+
+// WZZY returns a vector made of the elements WZZY of this one, like
+// the GLSL swizzle v.wzzy.
+func (v Vec4) WZZY() Vec4 {
+	return Vec4{v[3], v[2], v[2], v[1]}
+}
+
+// WZZZ returns a vector made of the elements WZZZ of this one, like
+// the GLSL swizzle v.wzzz.
+func (v Vec4) WZZZ() Vec4 {
+	return Vec4{v[3], v[2], v[2], v[2]}
+}
+
+// WZZW returns a vector made of the elements WZZW of this one, like
+// the GLSL swizzle v.wzzw.
+func (v Vec4) WZZW() Vec4 {
+	return Vec4{v[3], v[2], v[2], v[3]}
+}
+
+// WZWX returns a vector made of the elements WZWX of this one, like
+// the GLSL swizzle v.wzwx.
+func (v Vec4) WZWX() Vec4 {
+	return Vec4{v[3], v[2], v[3], v[0]}
+}
+
+// WZWY returns a vector made of the elements WZWY of this one, like
+// the GLSL swizzle v.wzwy.
+func (v Vec4) WZWY() Vec4 {
+	return Vec4{v[3], v[2], v[3], v[1]}
+}
+
+// WZWZ returns a vector made of the elements WZWZ of this one, like
+// the GLSL swizzle v.wzwz.
+func (v Vec4) WZWZ() Vec4 {
+	return Vec4{v[3], v[2], v[3], v[2]}
+}
+
+// WZWW returns a vector made of the elements WZWW of this one, like
+// the GLSL swizzle v.wzww.
+func (v Vec4) WZWW() Vec4 {
+	return Vec4{v[3], v[2], v[3], v[3]}
+}
+
+// WWXX returns a vector made of the elements WWXX of this one, like
+// the GLSL swizzle v.wwxx.
+func (v Vec4) WWXX() Vec4 {
+	return Vec4{v[3], v[3], v[0], v[0]}
+}
+
+// WWXY returns a vector made of the elements WWXY of this one, like
+// the GLSL swizzle v.wwxy.
+func (v Vec4) WWXY() Vec4 {
+	return Vec4{v[3], v[3], v[0], v[1]}
+}
+
+// WWXZ returns a vector made of the elements WWXZ of this one, like
+// the GLSL swizzle v.wwxz.
+func (v Vec4) WWXZ() Vec4 {
+	return Vec4{v[3], v[3], v[0], v[2]}
+}
+
+// WWXW returns a vector made of the elements WWXW of this one, like
+// the GLSL swizzle v.wwxw.
+func (v Vec4) WWXW() Vec4 {
+	return Vec4{v[3], v[3], v[0], v[3]}
+}
+
+// WWYX returns a vector made of the elements WWYX of this one, like
+// the GLSL swizzle v.wwyx.
+func (v Vec4) WWYX() Vec4 {
+	return Vec4{v[3], v[3], v[1], v[0]}
+}
+
+// WWYY returns a vector made of the elements WWYY of this one, like
+// the GLSL swizzle v.wwyy.
+func (v Vec4) WWYY() Vec4 {
+	return Vec4{v[3], v[3], v[1], v[1]}
+}
+
+// WWYZ returns a vector made of the elements WWYZ of this one, like
+// the GLSL swizzle v.wwyz.
+func (v Vec4) WWYZ() Vec4 {
+	return Vec4{v[3], v[3], v[1], v[2]}
+}
+
+// WWYW returns a vector made of the elements WWYW of this one, like
+// the GLSL swizzle v.wwyw.
+func (v Vec4) WWYW() Vec4 {
+	return Vec4{v[3], v[3], v[1], v[3]}
+}
+
+// WWZX returns a vector made of the elements WWZX of this one, like
+// the GLSL swizzle v.wwzx.
+func (v Vec4) WWZX() Vec4 {
+	return Vec4{v[3], v[3], v[2], v[0]}
+}
+
+// WWZY returns a vector made of the elements WWZY of this one, like
+// the GLSL swizzle v.wwzy.
+func (v Vec4) WWZY() Vec4 {
+	return Vec4{v[3], v[3], v[2], v[1]}
+}
+
+// WWZZ returns a vector made of the elements WWZZ of this one, like
+// the GLSL swizzle v.wwzz.
+func (v Vec4) WWZZ() Vec4 {
+	return Vec4{v[3], v[3], v[2], v[2]}
+}
+
+// WWZW returns a vector made of the elements WWZW of this one, like
+// the GLSL swizzle v.wwzw.
+func (v Vec4) WWZW() Vec4 {
+	return Vec4{v[3], v[3], v[2], v[3]}
+}
+
+// WWWX returns a vector made of the elements WWWX of this one, like
+// the GLSL swizzle v.wwwx.
+func (v Vec4) WWWX() Vec4 {
+	return Vec4{v[3], v[3], v[3], v[0]}
+}
+
+// WWWY returns a vector made of the elements WWWY of this one, like
+// the GLSL swizzle v.wwwy.
+func (v Vec4) WWWY() Vec4 {
+	return Vec4{v[3], v[3], v[3], v[1]}
+}
+
+// WWWZ returns a vector made of the elements WWWZ of this one, like
+// the GLSL swizzle v.wwwz.
+func (v Vec4) WWWZ() Vec4 {
+	return Vec4{v[3], v[3], v[3], v[2]}
+}
+
+// WWWW returns a vector made of the elements WWWW of this one, like
+// the GLSL swizzle v.wwww.
+func (v Vec4) WWWW() Vec4 {
+	return Vec4{v[3], v[3], v[3], v[3]}
+}
+
+// OuterProd2 does the vector outer product
+// of two vectors. The outer product produces an
+// 4x2 matrix. E.G. a Vec4 * Vec2 = Mat4x2.
+//
+// The outer product can be thought of as the "opposite"
 // of the Dot product. The Dot product treats both vectors like matrices
 // oriented such that the left one has N columns and the right has N rows.
 // So Vec3.Vec3 = Mat1x3*Mat3x1 = Mat1 = Scalar.
@@ -591,3 +3798,275 @@ func (v1 Vec4) OuterProd3(v2 Vec3) Mat4x3 {
 func (v1 Vec4) OuterProd4(v2 Vec4) Mat4 {
 	return Mat4{v1[0] * v2[0], v1[1] * v2[0], v1[2] * v2[0], v1[3] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[2] * v2[1], v1[3] * v2[1], v1[0] * v2[2], v1[1] * v2[2], v1[2] * v2[2], v1[3] * v2[2], v1[0] * v2[3], v1[1] * v2[3], v1[2] * v2[3], v1[3] * v2[3]}
 }
+
+// RoundingMode specifies how floating point values are rounded when they are
+// converted to integers, for instance by Vec3.Vec3i.
+type RoundingMode int
+
+// The rounding modes. RoundNearest rounds halfway cases away from zero.
+const (
+	RoundFloor RoundingMode = iota
+	RoundNearest
+	RoundCeil
+)
+
+// roundToInt rounds x to an integer as specified by mode.
+func roundToInt(x float64, mode RoundingMode) int {
+	switch mode {
+	case RoundNearest:
+		return int(math.Round(float64(x)))
+	case RoundCeil:
+		return int(math.Ceil(float64(x)))
+	default:
+		return int(math.Floor(float64(x)))
+	}
+}
+
+// Vec2i, Vec3i and Vec4i are integer vectors, for pixel coordinates, grid and
+// voxel indices and the like.
+type Vec2i [2]int
+type Vec3i [3]int
+type Vec4i [4]int
+
+// Vec2 converts the integer vector to a floating point vector.
+func (v Vec2i) Vec2() Vec2 {
+	return Vec2{float64(v[0]), float64(v[1])}
+}
+
+// Elem extracts the elements of the vector for direct value assignment.
+func (v Vec2i) Elem() (x, y int) {
+	return v[0], v[1]
+}
+
+// Add performs element-wise addition between two vectors.
+func (v1 Vec2i) Add(v2 Vec2i) Vec2i {
+	return Vec2i{v1[0] + v2[0], v1[1] + v2[1]}
+}
+
+// Sub performs element-wise subtraction between two vectors.
+func (v1 Vec2i) Sub(v2 Vec2i) Vec2i {
+	return Vec2i{v1[0] - v2[0], v1[1] - v2[1]}
+}
+
+// Mul performs a scalar multiplication between the vector and some constant
+// value c.
+func (v1 Vec2i) Mul(c int) Vec2i {
+	return Vec2i{v1[0] * c, v1[1] * c}
+}
+
+// Dot returns the dot product of this vector with another.
+func (v1 Vec2i) Dot(v2 Vec2i) int {
+	return v1[0]*v2[0] + v1[1]*v2[1]
+}
+
+// Min returns the element-wise minimum of the two vectors.
+func (v1 Vec2i) Min(v2 Vec2i) Vec2i {
+	for i := range v1 {
+		if v2[i] < v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// Max returns the element-wise maximum of the two vectors.
+func (v1 Vec2i) Max(v2 Vec2i) Vec2i {
+	for i := range v1 {
+		if v2[i] > v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// ManhattanLen returns the Manhattan (taxicab, L1) length of the vector, the
+// sum of the absolute values of its elements. The Manhattan distance between
+// two grid cells is v1.Sub(v2).ManhattanLen().
+func (v1 Vec2i) ManhattanLen() int {
+	l := 0
+	for _, x := range v1 {
+		if x < 0 {
+			x = -x
+		}
+		l += x
+	}
+	return l
+}
+
+// ChebyshevLen returns the Chebyshev (chessboard, L-infinity) length of the
+// vector, the largest absolute value of its elements. The Chebyshev distance
+// between two grid cells is v1.Sub(v2).ChebyshevLen().
+func (v1 Vec2i) ChebyshevLen() int {
+	l := 0
+	for _, x := range v1 {
+		if x < 0 {
+			x = -x
+		}
+		if x > l {
+			l = x
+		}
+	}
+	return l
+}
+
+// Vec3 converts the integer vector to a floating point vector.
+func (v Vec3i) Vec3() Vec3 {
+	return Vec3{float64(v[0]), float64(v[1]), float64(v[2])}
+}
+
+// Elem extracts the elements of the vector for direct value assignment.
+func (v Vec3i) Elem() (x, y, z int) {
+	return v[0], v[1], v[2]
+}
+
+// Add performs element-wise addition between two vectors.
+func (v1 Vec3i) Add(v2 Vec3i) Vec3i {
+	return Vec3i{v1[0] + v2[0], v1[1] + v2[1], v1[2] + v2[2]}
+}
+
+// Sub performs element-wise subtraction between two vectors.
+func (v1 Vec3i) Sub(v2 Vec3i) Vec3i {
+	return Vec3i{v1[0] - v2[0], v1[1] - v2[1], v1[2] - v2[2]}
+}
+
+// Mul performs a scalar multiplication between the vector and some constant
+// value c.
+func (v1 Vec3i) Mul(c int) Vec3i {
+	return Vec3i{v1[0] * c, v1[1] * c, v1[2] * c}
+}
+
+// Dot returns the dot product of this vector with another.
+func (v1 Vec3i) Dot(v2 Vec3i) int {
+	return v1[0]*v2[0] + v1[1]*v2[1] + v1[2]*v2[2]
+}
+
+// Min returns the element-wise minimum of the two vectors.
+func (v1 Vec3i) Min(v2 Vec3i) Vec3i {
+	for i := range v1 {
+		if v2[i] < v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// Max returns the element-wise maximum of the two vectors.
+func (v1 Vec3i) Max(v2 Vec3i) Vec3i {
+	for i := range v1 {
+		if v2[i] > v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// ManhattanLen returns the Manhattan (taxicab, L1) length of the vector, the
+// sum of the absolute values of its elements. The Manhattan distance between
+// two grid cells is v1.Sub(v2).ManhattanLen().
+func (v1 Vec3i) ManhattanLen() int {
+	l := 0
+	for _, x := range v1 {
+		if x < 0 {
+			x = -x
+		}
+		l += x
+	}
+	return l
+}
+
+// ChebyshevLen returns the Chebyshev (chessboard, L-infinity) length of the
+// vector, the largest absolute value of its elements. The Chebyshev distance
+// between two grid cells is v1.Sub(v2).ChebyshevLen().
+func (v1 Vec3i) ChebyshevLen() int {
+	l := 0
+	for _, x := range v1 {
+		if x < 0 {
+			x = -x
+		}
+		if x > l {
+			l = x
+		}
+	}
+	return l
+}
+
+// Vec4 converts the integer vector to a floating point vector.
+func (v Vec4i) Vec4() Vec4 {
+	return Vec4{float64(v[0]), float64(v[1]), float64(v[2]), float64(v[3])}
+}
+
+// Elem extracts the elements of the vector for direct value assignment.
+func (v Vec4i) Elem() (x, y, z, w int) {
+	return v[0], v[1], v[2], v[3]
+}
+
+// Add performs element-wise addition between two vectors.
+func (v1 Vec4i) Add(v2 Vec4i) Vec4i {
+	return Vec4i{v1[0] + v2[0], v1[1] + v2[1], v1[2] + v2[2], v1[3] + v2[3]}
+}
+
+// Sub performs element-wise subtraction between two vectors.
+func (v1 Vec4i) Sub(v2 Vec4i) Vec4i {
+	return Vec4i{v1[0] - v2[0], v1[1] - v2[1], v1[2] - v2[2], v1[3] - v2[3]}
+}
+
+// Mul performs a scalar multiplication between the vector and some constant
+// value c.
+func (v1 Vec4i) Mul(c int) Vec4i {
+	return Vec4i{v1[0] * c, v1[1] * c, v1[2] * c, v1[3] * c}
+}
+
+// Dot returns the dot product of this vector with another.
+func (v1 Vec4i) Dot(v2 Vec4i) int {
+	return v1[0]*v2[0] + v1[1]*v2[1] + v1[2]*v2[2] + v1[3]*v2[3]
+}
+
+// Min returns the element-wise minimum of the two vectors.
+func (v1 Vec4i) Min(v2 Vec4i) Vec4i {
+	for i := range v1 {
+		if v2[i] < v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// Max returns the element-wise maximum of the two vectors.
+func (v1 Vec4i) Max(v2 Vec4i) Vec4i {
+	for i := range v1 {
+		if v2[i] > v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// ManhattanLen returns the Manhattan (taxicab, L1) length of the vector, the
+// sum of the absolute values of its elements. The Manhattan distance between
+// two grid cells is v1.Sub(v2).ManhattanLen().
+func (v1 Vec4i) ManhattanLen() int {
+	l := 0
+	for _, x := range v1 {
+		if x < 0 {
+			x = -x
+		}
+		l += x
+	}
+	return l
+}
+
+// ChebyshevLen returns the Chebyshev (chessboard, L-infinity) length of the
+// vector, the largest absolute value of its elements. The Chebyshev distance
+// between two grid cells is v1.Sub(v2).ChebyshevLen().
+func (v1 Vec4i) ChebyshevLen() int {
+	l := 0
+	for _, x := range v1 {
+		if x < 0 {
+			x = -x
+		}
+		if x > l {
+			l = x
+		}
+	}
+	return l
+}